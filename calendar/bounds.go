@@ -0,0 +1,77 @@
+package calendar
+
+import (
+	"time"
+)
+
+// maxDateSearch is the number of steps taken when searching for a selectable date before giving up, which keeps
+// navigation from spinning forever when every date is disabled.
+const maxDateSearch = 3660
+
+// dateBounds restricts which dates may become the active date.
+type dateBounds struct {
+	// minDate is the first date that may be active, or the zero time if there is no minimum
+	minDate time.Time
+	// maxDate is the last date that may be active, or the zero time if there is no maximum
+	maxDate time.Time
+
+	// disabled optionally reports dates that may not be active
+	disabled func(date time.Time) bool
+
+	// holidays optionally provides holidays that may not be active
+	holidays HolidayProvider
+}
+
+// inRange determines if a date falls between the minimum and maximum dates.
+func (b dateBounds) inRange(date time.Time) bool {
+	date = normalizeDate(date)
+	if (b.minDate != (time.Time{})) && date.Before(b.minDate) {
+		return false
+	}
+	if (b.maxDate != (time.Time{})) && date.After(b.maxDate) {
+		return false
+	}
+	return true
+}
+
+// in re-expresses the minimum and maximum dates in another location, keeping their calendar dates.
+func (b dateBounds) in(loc *time.Location) dateBounds {
+	b.minDate = sameDateIn(b.minDate, loc)
+	b.maxDate = sameDateIn(b.maxDate, loc)
+	return b
+}
+
+// isDisabled determines if a date falls outside of the bounds, is a skipped holiday or is disabled by the predicate.
+func (b dateBounds) isDisabled(date time.Time) bool {
+	if !b.inRange(date) {
+		return true
+	}
+	if _, ok := holidayOn(b.holidays, date); ok {
+		return true
+	}
+	return (b.disabled != nil) && b.disabled(normalizeDate(date))
+}
+
+// selectable determines if a date may become the active date.
+func (b dateBounds) selectable(weekdays Weekdays, date time.Time) bool {
+	return weekdays.IsVisible(date.Weekday()) && !b.isDisabled(date)
+}
+
+// step moves from the date in increments of step days until a selectable date is found.
+//
+// If the search moves past the minimum or maximum date, ok is false.
+func (b dateBounds) step(weekdays Weekdays, date time.Time, step int) (next time.Time, ok bool) {
+	for i := 1; i <= maxDateSearch; i++ {
+		d := date.AddDate(0, 0, step*i)
+		if (step < 0) && (b.minDate != (time.Time{})) && normalizeDate(d).Before(b.minDate) {
+			return time.Time{}, false
+		}
+		if (step > 0) && (b.maxDate != (time.Time{})) && normalizeDate(d).After(b.maxDate) {
+			return time.Time{}, false
+		}
+		if b.selectable(weekdays, d) {
+			return d, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,264 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+// isWeekend disables Saturdays and Sundays.
+func isWeekend(date time.Time) bool {
+	return (date.Weekday() == time.Saturday) || (date.Weekday() == time.Sunday)
+}
+
+func Test_dateBounds_step(t *testing.T) {
+	tests := []struct {
+		name   string
+		bounds dateBounds
+		date   time.Time
+		step   int
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name:   "unbounded",
+			bounds: dateBounds{},
+			date:   newDate(2024, time.September, 10),
+			step:   1,
+			want:   newDate(2024, time.September, 11),
+			wantOk: true,
+		},
+		{
+			name:   "skip-disabled",
+			bounds: dateBounds{disabled: isWeekend},
+			date:   newDate(2024, time.September, 13),
+			step:   1,
+			want:   newDate(2024, time.September, 16),
+			wantOk: true,
+		},
+		{
+			name:   "skip-disabled-week",
+			bounds: dateBounds{disabled: func(date time.Time) bool { return date.Day() == 17 }},
+			date:   newDate(2024, time.September, 10),
+			step:   7,
+			want:   newDate(2024, time.September, 24),
+			wantOk: true,
+		},
+		{
+			name:   "past-max",
+			bounds: dateBounds{maxDate: newDate(2024, time.September, 12)},
+			date:   newDate(2024, time.September, 12),
+			step:   1,
+			want:   time.Time{},
+			wantOk: false,
+		},
+		{
+			name:   "past-min-after-disabled",
+			bounds: dateBounds{minDate: newDate(2024, time.September, 8), disabled: isWeekend},
+			date:   newDate(2024, time.September, 9),
+			step:   -1,
+			want:   time.Time{},
+			wantOk: false,
+		},
+		{
+			name:   "toward-min",
+			bounds: dateBounds{minDate: newDate(2024, time.September, 8)},
+			date:   newDate(2024, time.September, 1),
+			step:   1,
+			want:   newDate(2024, time.September, 8),
+			wantOk: true,
+		},
+		{
+			name:   "all-disabled",
+			bounds: dateBounds{disabled: func(time.Time) bool { return true }},
+			date:   newDate(2024, time.September, 10),
+			step:   1,
+			want:   time.Time{},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got, gotOk := tt.bounds.step(DefaultWeekdays(), tt.date, tt.step)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
+
+func TestMonthModel_Update_Bounds(t *testing.T) {
+	tests := []struct {
+		name          string
+		activeDay     int
+		msgs          []tea.Msg
+		wantActiveDay int
+		wantMsgs      []tea.Msg
+	}{
+		{
+			name: "right-uninitialized",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+			},
+			wantActiveDay: 9,
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 9)},
+			},
+		},
+		{
+			name:      "right-over-weekend",
+			activeDay: 13,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+			},
+			wantActiveDay: 16,
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 16)},
+			},
+		},
+		{
+			name:      "left-at-min",
+			activeDay: 9,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyLeft},
+				tea.KeyMsg{Type: tea.KeyUp},
+			},
+			wantActiveDay: 9,
+			wantMsgs:      nil,
+		},
+		{
+			name:      "down-at-max",
+			activeDay: 16,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+				tea.KeyMsg{Type: tea.KeyDown},
+			},
+			wantActiveDay: 23,
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 23)},
+			},
+		},
+		{
+			name:      "next-month-out-of-bounds",
+			activeDay: 16,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantActiveDay: 0,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2024, Month: time.October},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewMonth(2024, time.September).
+				MinDate(newDate(2024, time.September, 9)).
+				MaxDate(newDate(2024, time.September, 27)).
+				DisabledFunc(isWeekend)
+			tm.activeDay = tt.activeDay
+			_ = tm.Init()
+
+			// Test
+			var gotMsgs []tea.Msg
+			for _, msg := range tt.msgs {
+				gotModel, gotCmd := tm.Update(msg)
+				tm = gotModel.(MonthModel)
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+			}
+
+			// Assertions
+			assert.Equal(t, tt.wantActiveDay, tm.activeDay)
+			assert.Equal(t, tt.wantMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestWeekModel_Update_Bounds(t *testing.T) {
+	tests := []struct {
+		name           string
+		activeDate     time.Time
+		msgs           []tea.Msg
+		wantActiveDate time.Time
+		wantMsgs       []tea.Msg
+	}{
+		{
+			name: "left-uninitialized",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyLeft},
+			},
+			wantActiveDate: newDate(2024, time.September, 27),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 27)},
+			},
+		},
+		{
+			name:       "left-over-blackout",
+			activeDate: newDate(2024, time.September, 26),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyLeft},
+			},
+			wantActiveDate: newDate(2024, time.September, 24),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 24)},
+			},
+		},
+		{
+			name:       "previous-week-onto-blackout",
+			activeDate: newDate(2024, time.October, 2),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgUp},
+			},
+			wantActiveDate: newDate(2024, time.September, 26),
+			wantMsgs: []tea.Msg{
+				WeekChangedMsg{Start: newDate(2024, time.September, 22), End: newDate(2024, time.September, 28)},
+				ActiveDateMsg{Date: newDate(2024, time.September, 26)},
+			},
+		},
+		{
+			name:       "previous-week-out-of-bounds",
+			activeDate: newDate(2024, time.September, 23),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgUp},
+			},
+			wantActiveDate: time.Time{},
+			wantMsgs: []tea.Msg{
+				WeekChangedMsg{Start: newDate(2024, time.September, 15), End: newDate(2024, time.September, 21)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewWeek(newDate(2024, time.September, 24)).
+				MinDate(newDate(2024, time.September, 23)).
+				DisabledFunc(func(date time.Time) bool {
+					return isWeekend(date) || date.Equal(newDate(2024, time.September, 25))
+				})
+			if tt.activeDate != (time.Time{}) {
+				tm = tm.setActiveDate(tt.activeDate)
+			}
+			_ = tm.Init()
+
+			// Test
+			var gotMsgs []tea.Msg
+			for _, msg := range tt.msgs {
+				gotModel, gotCmd := tm.Update(msg)
+				tm = gotModel.(WeekModel)
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+			}
+
+			// Assertions
+			assert.Equal(t, tt.wantActiveDate, tm.activeDate)
+			assert.Equal(t, tt.wantMsgs, gotMsgs)
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// DatePickedMsg notifies to other models that a date was picked.
+type DatePickedMsg struct {
+	// The picked date
+	Date time.Time
+}
+
+// DatePickCanceledMsg notifies to other models that picking a date was canceled.
+type DatePickCanceledMsg struct{}
+
+// DatePickerModel picks a single date from a compact month calendar, or from a date typed into an optional text
+// field.
+type DatePickerModel struct {
+	// keyMap is key bindings for date picking
+	keyMap KeyMap
+
+	// month is the compact calendar used to browse dates
+	month MonthModel
+
+	// showInput enables the text field
+	showInput bool
+	// inputFocused is true when key presses edit the text field rather than navigate the calendar
+	inputFocused bool
+	// input is the text typed into the text field
+	input []rune
+	// inputLayout is the time layout used to parse and format the text field
+	inputLayout string
+	// inputInvalid is true when the text field could not be parsed when picking a date
+	inputInvalid bool
+
+	// Styles
+	styles DatePickerStyles
+}
+
+// NewDatePicker creates a new DatePickerModel, with the given date as the active date.
+func NewDatePicker(date time.Time) DatePickerModel {
+	m := DatePickerModel{
+		keyMap: DefaultDatePickerKeyMap(),
+
+		month: NewMonth(date.Year(), date.Month()).
+			Weekdays(DefaultWeekdaysShort()),
+
+		inputLayout: time.DateOnly,
+
+		styles: DefaultDatePickerStyles(),
+	}
+	m.month.keyMap = m.keyMap
+	m.month.styles = m.styles.MonthStyles
+	m.month = m.month.setActiveDate(normalizeDate(date))
+	m.input = []rune(m.Date().Format(m.inputLayout))
+
+	return m
+}
+
+// StartOfWeek sets the first day of a week.
+func (m DatePickerModel) StartOfWeek(weekday time.Weekday) DatePickerModel {
+	m.month = m.month.StartOfWeek(weekday)
+	return m
+}
+
+// Weekdays sets custom weekday labels.
+func (m DatePickerModel) Weekdays(weekdays Weekdays) DatePickerModel {
+	m.month = m.month.Weekdays(weekdays)
+	return m
+}
+
+// Locale sets the month names, weekday labels and first day of the week.
+//
+// The locale's narrow weekday labels are used to keep the calendar compact.
+func (m DatePickerModel) Locale(locale Locale) DatePickerModel {
+	m.month = m.month.Locale(locale).Weekdays(locale.ShortWeekdays)
+	return m
+}
+
+// Location sets the time zone in which picked dates are reported and typed dates are parsed, which defaults to UTC.
+func (m DatePickerModel) Location(loc *time.Location) DatePickerModel {
+	m.month = m.month.Location(loc)
+	return m
+}
+
+// MinDate sets the first date that may be picked. The zero time removes the minimum.
+func (m DatePickerModel) MinDate(date time.Time) DatePickerModel {
+	m.month = m.month.MinDate(date)
+	return m
+}
+
+// MaxDate sets the last date that may be picked. The zero time removes the maximum.
+func (m DatePickerModel) MaxDate(date time.Time) DatePickerModel {
+	m.month = m.month.MaxDate(date)
+	return m
+}
+
+// DisabledFunc sets a function that reports dates that may not be picked.
+func (m DatePickerModel) DisabledFunc(f func(date time.Time) bool) DatePickerModel {
+	m.month = m.month.DisabledFunc(f)
+	return m
+}
+
+// ShowInput enables or disables the text field for typing a date.
+func (m DatePickerModel) ShowInput(enabled bool) DatePickerModel {
+	m.showInput = enabled
+	if !enabled {
+		m.inputFocused = false
+	}
+	return m
+}
+
+// InputLayout sets the time layout used to parse and format the text field, e.g. "01/02/2006".
+func (m DatePickerModel) InputLayout(layout string) DatePickerModel {
+	m.inputLayout = layout
+	m = m.syncInput()
+	return m
+}
+
+// Styles sets custom styling.
+func (m DatePickerModel) Styles(styles DatePickerStyles) DatePickerModel {
+	m.styles = styles
+	m.month.styles = styles.MonthStyles
+	return m
+}
+
+// Date returns the active date.
+func (m DatePickerModel) Date() time.Time {
+	return m.month.ActiveDate()
+}
+
+// InputFocused returns true when key presses edit the text field rather than navigate the calendar.
+func (m DatePickerModel) InputFocused() bool {
+	return m.inputFocused
+}
+
+// syncInput replaces the text field with the formatted active date.
+func (m DatePickerModel) syncInput() DatePickerModel {
+	m.input = []rune(m.Date().Format(m.inputLayout))
+	m.inputInvalid = false
+	return m
+}
+
+// parseInput parses the text field. Dates that may not be picked are treated as invalid.
+func (m DatePickerModel) parseInput() (time.Time, bool) {
+	date, err := time.ParseInLocation(m.inputLayout, string(m.input), m.month.location)
+	if err != nil || m.month.IsDisabled(date) {
+		return time.Time{}, false
+	}
+	return normalizeDate(date), true
+}
+
+// Init the DatePickerModel.
+func (m DatePickerModel) Init() tea.Cmd { return nil }
+
+// Update the DatePickerModel.
+func (m DatePickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.keyMap.Select):
+		date := m.Date()
+		if m.inputFocused {
+			parsed, ok := m.parseInput()
+			if !ok {
+				m.inputInvalid = true
+				return m, nil
+			}
+			date = parsed
+			m.month = m.month.setActiveDate(date)
+		}
+		if (date == time.Time{}) || m.month.IsDisabled(date) {
+			return m, nil
+		}
+
+		return m, func() tea.Msg {
+			return DatePickedMsg{
+				Date: date,
+			}
+		}
+	case key.Matches(keyMsg, m.keyMap.Cancel):
+		return m, func() tea.Msg {
+			return DatePickCanceledMsg{}
+		}
+	case key.Matches(keyMsg, m.keyMap.Focus):
+		if m.showInput {
+			m.inputFocused = !m.inputFocused
+		}
+		return m, nil
+	}
+
+	if m.inputFocused {
+		switch keyMsg.Type {
+		case tea.KeyRunes, tea.KeySpace:
+			m.input = append(m.input, keyMsg.Runes...)
+		case tea.KeyBackspace:
+			if len(m.input) > 0 {
+				m.input = m.input[:len(m.input)-1]
+			}
+		default:
+			return m, nil
+		}
+
+		// Follow the typed date on the calendar as soon as it is complete
+		m.inputInvalid = false
+		if date, ok := m.parseInput(); ok {
+			m.month = m.month.setActiveDate(date)
+		}
+		return m, nil
+	}
+
+	oldDate := m.Date()
+
+	month, cmd := m.month.Update(keyMsg)
+	m.month = month.(MonthModel)
+
+	if !m.Date().Equal(oldDate) {
+		m = m.syncInput()
+	}
+
+	return m, cmd
+}
+
+// View renders the DatePickerModel inline.
+func (m DatePickerModel) View() string {
+	return m.styles.InlineStyle.Render(m.viewPicker())
+}
+
+// ViewPopover renders the DatePickerModel as a popover.
+func (m DatePickerModel) ViewPopover() string {
+	return m.styles.PopoverStyle.Render(m.viewPicker())
+}
+
+// ViewOverlay renders the DatePickerModel as a popover on top of the background, with the top-left corner of the
+// popover at column x and line y of the background.
+func (m DatePickerModel) ViewOverlay(background string, x int, y int) string {
+	return overlay(background, m.ViewPopover(), x, y)
+}
+
+// viewPicker renders the text field, title, and calendar.
+func (m DatePickerModel) viewPicker() string {
+	calendar := gloss.JoinVertical(
+		gloss.Left,
+		m.month.ViewHeaders(),
+		m.month.ViewWeeks(),
+	)
+	width := gloss.Width(calendar)
+
+	var lines []string
+	if m.showInput {
+		lines = append(lines, m.ViewInput(width))
+	}
+	lines = append(lines,
+		m.styles.TitleStyle.Width(width).Render(m.month.Title(true)),
+		calendar,
+	)
+
+	return gloss.JoinVertical(gloss.Left, lines...)
+}
+
+// ViewInput renders the text field.
+func (m DatePickerModel) ViewInput(width int) string {
+	style := m.styles.InputStyle
+	switch {
+	case m.inputInvalid:
+		style = m.styles.InvalidInputStyle
+	case m.inputFocused:
+		style = m.styles.ActiveInputStyle
+	}
+
+	text := style.Render(string(m.input))
+	if len(m.input) == 0 {
+		text = m.styles.PlaceholderStyle.Render(m.inputLayout)
+	}
+	if m.inputFocused {
+		text += m.styles.CursorStyle.Render(" ")
+	}
+
+	return gloss.NewStyle().Width(width).Render(text)
+}
+
+// overlay places the foreground on top of the background, with the top-left corner of the foreground at column x
+// and line y of the background. The background is extended if the foreground does not fit.
+func overlay(background string, foreground string, x int, y int) string {
+	x, y = max(0, x), max(0, y)
+
+	lines := strings.Split(background, "\n")
+	for i, line := range strings.Split(foreground, "\n") {
+		row := y + i
+		for row >= len(lines) {
+			lines = append(lines, "")
+		}
+
+		left := ansi.Truncate(lines[row], x, "")
+		if w := ansi.StringWidth(left); w < x {
+			left += strings.Repeat(" ", x-w)
+		}
+		right := skipCells(lines[row], x+ansi.StringWidth(line))
+
+		lines[row] = left + line + right
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// skipCells removes the first n cells of printable text from a line. Escape sequences are kept so that styling
+// carries over to the remaining text.
+func skipCells(s string, n int) string {
+	var b strings.Builder
+	var state byte
+	for len(s) > 0 {
+		seq, width, size, newState := ansi.DecodeSequence(s, state, nil)
+		state = newState
+		s = s[size:]
+
+		switch {
+		case (width == 0) || (n <= 0):
+			b.WriteString(seq)
+		case width > n:
+			// Replace the uncovered half of a wide character
+			b.WriteString(strings.Repeat(" ", width-n))
+			n = 0
+		default:
+			n -= width
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,258 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewDatePicker(t *testing.T) {
+	// Test
+	got := NewDatePicker(time.Date(2024, time.September, 10, 15, 4, 0, 0, time.UTC))
+
+	// Assertions
+	assert.Equal(t, newDate(2024, time.September, 10), got.Date())
+	assert.Equal(t, "2024-09-10", string(got.input))
+	assert.False(t, got.showInput)
+	assert.False(t, got.InputFocused())
+}
+
+func TestDatePickerModel_InputLayout(t *testing.T) {
+	// Setup
+	tm := NewDatePicker(newDate(2024, time.September, 10))
+
+	// Test
+	got := tm.InputLayout("01/02/2006")
+
+	// Assertions
+	assert.Equal(t, "09/10/2024", string(got.input))
+}
+
+func TestDatePickerModel_Update(t *testing.T) {
+	tests := []struct {
+		name        string
+		showInput   bool
+		msgs        []tea.Msg
+		wantDate    time.Time
+		wantInput   string
+		wantInvalid bool
+		wantMsgs    []tea.Msg
+	}{
+		{
+			name: "navigate",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+			},
+			wantDate:  newDate(2024, time.September, 17),
+			wantInput: "2024-09-17",
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 17)},
+			},
+		},
+		{
+			name: "next-month",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantDate:  newDate(2024, time.October, 10),
+			wantInput: "2024-10-10",
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2024, Month: time.October},
+				ActiveDateMsg{Date: newDate(2024, time.October, 10)},
+			},
+		},
+		{
+			name: "pick",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantDate:  newDate(2024, time.September, 11),
+			wantInput: "2024-09-11",
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 11)},
+				DatePickedMsg{Date: newDate(2024, time.September, 11)},
+			},
+		},
+		{
+			name: "cancel",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyEsc},
+			},
+			wantDate:  newDate(2024, time.September, 10),
+			wantInput: "2024-09-10",
+			wantMsgs: []tea.Msg{
+				DatePickCanceledMsg{},
+			},
+		},
+		{
+			name: "focus-without-input",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyTab},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")},
+			},
+			wantDate:  newDate(2024, time.September, 10),
+			wantInput: "2024-09-10",
+			wantMsgs:  nil,
+		},
+		{
+			name:      "type",
+			showInput: true,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyTab},
+				tea.KeyMsg{Type: tea.KeyBackspace},
+				tea.KeyMsg{Type: tea.KeyBackspace},
+				tea.KeyMsg{Type: tea.KeyBackspace},
+				tea.KeyMsg{Type: tea.KeyBackspace},
+				tea.KeyMsg{Type: tea.KeyBackspace},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("12-25")},
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantDate:  newDate(2024, time.December, 25),
+			wantInput: "2024-12-25",
+			wantMsgs: []tea.Msg{
+				DatePickedMsg{Date: newDate(2024, time.December, 25)},
+			},
+		},
+		{
+			name:      "type-invalid",
+			showInput: true,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyTab},
+				tea.KeyMsg{Type: tea.KeyBackspace},
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantDate:    newDate(2024, time.September, 10),
+			wantInput:   "2024-09-1",
+			wantInvalid: true,
+			wantMsgs:    nil,
+		},
+		{
+			name:      "type-then-navigate",
+			showInput: true,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyTab},
+				tea.KeyMsg{Type: tea.KeyBackspace},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")},
+				tea.KeyMsg{Type: tea.KeyTab},
+				tea.KeyMsg{Type: tea.KeyLeft},
+			},
+			wantDate:  newDate(2024, time.September, 14),
+			wantInput: "2024-09-14",
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 14)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewDatePicker(newDate(2024, time.September, 10)).ShowInput(tt.showInput)
+			_ = tm.Init()
+
+			// Test
+			var gotMsgs []tea.Msg
+			for _, msg := range tt.msgs {
+				gotModel, gotCmd := tm.Update(msg)
+				tm = gotModel.(DatePickerModel)
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+			}
+
+			// Assertions
+			assert.Equal(t, tt.wantDate, tm.Date())
+			assert.Equal(t, tt.wantInput, string(tm.input))
+			assert.Equal(t, tt.wantInvalid, tm.inputInvalid)
+			assert.Equal(t, tt.wantMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestDatePickerModel_View(t *testing.T) {
+	tests := []struct {
+		name      string
+		showInput bool
+		popover   bool
+	}{
+		{
+			name: "inline",
+		},
+		{
+			name:      "inline-input",
+			showInput: true,
+		},
+		{
+			name:      "popover-input",
+			showInput: true,
+			popover:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewDatePicker(newDate(2024, time.September, 10)).ShowInput(tt.showInput)
+			_ = tm.Init()
+
+			// Test
+			view := tm.View()
+			if tt.popover {
+				view = tm.ViewPopover()
+			}
+			got := ansi.Strip(view)
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func Test_overlay(t *testing.T) {
+	tests := []struct {
+		name       string
+		background string
+		foreground string
+		x          int
+		y          int
+		want       string
+	}{
+		{
+			name:       "inside",
+			background: "abcdef\nghijkl\nmnopqr",
+			foreground: "12\n34",
+			x:          2,
+			y:          1,
+			want:       "abcdef\ngh12kl\nmn34qr",
+		},
+		{
+			name:       "past-edges",
+			background: "abc\ndef",
+			foreground: "12\n34",
+			x:          4,
+			y:          1,
+			want:       "abc\ndef 12\n    34",
+		},
+		{
+			name:       "styled-background",
+			background: "\x1b[1mabcdef\x1b[m",
+			foreground: "12",
+			x:          1,
+			y:          0,
+			want:       "\x1b[1ma\x1b[m12\x1b[1mdef\x1b[m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := overlay(tt.background, tt.foreground, tt.x, tt.y)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package calendar
+
+import (
+	"cmp"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+)
+
+// ActiveSlotMsg notifies to other models which time slot is set as the active slot.
+type ActiveSlotMsg struct {
+	// Start of the time slot
+	Start time.Time
+
+	// End of the time slot
+	End time.Time
+}
+
+// SlotSelectedMsg notifies to other models that a time slot was selected.
+type SlotSelectedMsg struct {
+	// Start of the time slot
+	Start time.Time
+
+	// End of the time slot
+	End time.Time
+}
+
+// DayModel represents a single date as a timeline of time slots.
+type DayModel struct {
+	// keyMap is key bindings for timeline navigation
+	keyMap KeyMap
+
+	// date to represent
+	date time.Time
+
+	// locale provides the date format
+	locale Locale
+
+	// grid describes the time slots of the timeline
+	grid timeGrid
+
+	// height is the number of visible time slots. If zero, every time slot is visible.
+	height int
+	// offset is the index of the first visible time slot
+	offset int
+
+	// activeSlot is the index of the active time slot, or -1 if no slot is active
+	activeSlot int
+
+	// appointments to place on the timeline
+	appointments []Appointment
+
+	// Styles
+	styles DayStyles
+}
+
+// NewDay creates a new DayModel.
+func NewDay(date time.Time) DayModel {
+	m := DayModel{
+		keyMap: DefaultDayKeyMap(),
+
+		date: time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location()),
+
+		locale: DefaultLocale(),
+
+		grid: defaultTimeGrid(),
+
+		activeSlot: -1,
+
+		styles: DefaultDayStyles(),
+	}
+
+	return m
+}
+
+// TimeRange sets the time of day at which the timeline starts and ends, as offsets from midnight.
+func (m DayModel) TimeRange(start time.Duration, end time.Duration) DayModel {
+	m.grid.startTime = start
+	m.grid.endTime = max(start, end)
+	m.activeSlot = min(m.activeSlot, m.Slots()-1)
+	m.offset = 0
+	return m
+}
+
+// SlotDuration sets the length of each time slot.
+func (m DayModel) SlotDuration(d time.Duration) DayModel {
+	if d <= 0 {
+		return m
+	}
+	m.grid.slotDuration = d
+	m.activeSlot = min(m.activeSlot, m.Slots()-1)
+	m.offset = 0
+	return m
+}
+
+// Height sets the number of visible time slots. If zero, every time slot is visible.
+func (m DayModel) Height(slots int) DayModel {
+	m.height = max(0, slots)
+	return m.scrollToActiveSlot()
+}
+
+// Appointments sets the appointments to place on the timeline.
+//
+// Appointments may also be set with an AppointmentsMsg for the model's date.
+func (m DayModel) Appointments(appointments ...Appointment) DayModel {
+	m.appointments = appointments
+	return m
+}
+
+// Locale sets the date format of the header.
+func (m DayModel) Locale(locale Locale) DayModel {
+	m.locale = locale
+	return m
+}
+
+// Styles sets custom styling.
+func (m DayModel) Styles(styles DayStyles) DayModel {
+	m.styles = styles
+	return m
+}
+
+// Slots returns the number of time slots in the timeline.
+func (m DayModel) Slots() int {
+	return m.grid.slots()
+}
+
+// SlotStart returns the start time of a time slot.
+func (m DayModel) SlotStart(slot int) time.Time {
+	return m.grid.slotStart(m.date, slot)
+}
+
+// ActiveSlot returns the start and end of the active time slot. If no slot is active, ok is false.
+func (m DayModel) ActiveSlot() (start time.Time, end time.Time, ok bool) {
+	if m.activeSlot < 0 {
+		return time.Time{}, time.Time{}, false
+	}
+	start = m.SlotStart(m.activeSlot)
+	return start, start.Add(m.grid.slotDuration), true
+}
+
+// visibleSlots returns the number of time slots that fit in the viewport.
+func (m DayModel) visibleSlots() int {
+	if m.height == 0 {
+		return m.Slots()
+	}
+	return min(m.height, m.Slots())
+}
+
+// scroll moves the viewport by n time slots.
+func (m DayModel) scroll(n int) DayModel {
+	m.offset = max(0, min(m.offset+n, m.Slots()-m.visibleSlots()))
+	return m
+}
+
+// scrollToActiveSlot moves the viewport so that the active time slot is visible.
+func (m DayModel) scrollToActiveSlot() DayModel {
+	if m.activeSlot < 0 {
+		return m
+	}
+	if m.activeSlot < m.offset {
+		m.offset = m.activeSlot
+	}
+	if m.activeSlot >= m.offset+m.visibleSlots() {
+		m.offset = m.activeSlot - m.visibleSlots() + 1
+	}
+	return m
+}
+
+// Init the DayModel.
+func (m DayModel) Init() tea.Cmd { return nil }
+
+// Update the DayModel.
+func (m DayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Without time slots, there is no slot to make active
+		if (m.Slots() == 0) && key.Matches(msg, m.keyMap.Up, m.keyMap.Down) {
+			return m, nil
+		}
+
+		oldActiveSlot := m.activeSlot
+		switch {
+		case key.Matches(msg, m.keyMap.Up):
+			// If initializing the active slot, start from the first visible slot
+			if m.activeSlot < 0 {
+				m.activeSlot = m.offset
+				break
+			}
+			m.activeSlot = max(0, m.activeSlot-1)
+			m = m.scrollToActiveSlot()
+		case key.Matches(msg, m.keyMap.Down):
+			// If initializing the active slot, start from the first visible slot
+			if m.activeSlot < 0 {
+				m.activeSlot = m.offset
+				break
+			}
+			m.activeSlot = min(m.Slots()-1, m.activeSlot+1)
+			m = m.scrollToActiveSlot()
+		case key.Matches(msg, m.keyMap.ScrollUp):
+			m = m.scroll(-1 * m.slotsPerHour())
+		case key.Matches(msg, m.keyMap.ScrollDown):
+			m = m.scroll(m.slotsPerHour())
+		case key.Matches(msg, m.keyMap.Select):
+			if start, end, ok := m.ActiveSlot(); ok {
+				cmds = append(cmds, func() tea.Msg {
+					return SlotSelectedMsg{
+						Start: start,
+						End:   end,
+					}
+				})
+			}
+		}
+
+		if oldActiveSlot != m.activeSlot {
+			start, end, _ := m.ActiveSlot()
+			cmds = append(cmds, func() tea.Msg {
+				return ActiveSlotMsg{
+					Start: start,
+					End:   end,
+				}
+			})
+		}
+	case AppointmentsMsg:
+		if dateIn(msg.Date, m.date.Location()) != normalizeDate(m.date) {
+			break
+		}
+		m.appointments = msg.Appointments
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// slotsPerHour calculates the number of time slots in an hour, which is used as the scroll distance.
+func (m DayModel) slotsPerHour() int {
+	return max(1, int(time.Hour/m.grid.slotDuration))
+}
+
+// View renders the DayModel.
+func (m DayModel) View() string {
+	return gloss.JoinVertical(
+		gloss.Left,
+		m.ViewHeader(),
+		m.ViewSlots(),
+	)
+}
+
+// ViewHeader renders the date header.
+func (m DayModel) ViewHeader() string {
+	gutter := m.styles.TimeStyle.Render("")
+	header := m.styles.HeaderStyle.Width(m.styles.Width).Render(m.locale.Format(m.date, cmp.Or(m.styles.DateFormat, m.locale.LongDateFormat)))
+
+	return gloss.JoinHorizontal(gloss.Top, gutter, header)
+}
+
+// ViewSlots renders the visible time slots.
+func (m DayModel) ViewSlots() string {
+	gutter := m.grid.viewGutter(m.date, m.offset, m.visibleSlots(), m.activeSlot, m.styles)
+	column := m.grid.viewColumn(m.date, m.appointments, m.styles.Width, m.offset, m.visibleSlots(), m.activeSlot, m.styles)
+
+	return gloss.JoinHorizontal(gloss.Top, strings.Join(gutter, "\n"), strings.Join(column, "\n"))
+}
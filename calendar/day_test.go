@@ -0,0 +1,351 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTime(year int, month time.Month, day int, hour int, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func Test_NewDay(t *testing.T) {
+	// Test
+	got := NewDay(newTime(2024, time.October, 2, 13, 45))
+
+	// Assertions
+	assert.Equal(t, newDate(2024, time.October, 2), got.date)
+	assert.Equal(t, 7*time.Hour, got.grid.startTime)
+	assert.Equal(t, 20*time.Hour, got.grid.endTime)
+	assert.Equal(t, 30*time.Minute, got.grid.slotDuration)
+	assert.Equal(t, -1, got.activeSlot)
+	assert.Equal(t, 26, got.Slots())
+}
+
+func TestDayModel_TimeRange(t *testing.T) {
+	// Setup
+	tm := NewDay(newDate(2024, time.October, 2))
+	tm.activeSlot = 20
+
+	// Test
+	got := tm.TimeRange(9*time.Hour, 17*time.Hour)
+
+	// Assertions
+	assert.Equal(t, 9*time.Hour, got.grid.startTime)
+	assert.Equal(t, 17*time.Hour, got.grid.endTime)
+	assert.Equal(t, 16, got.Slots())
+	assert.Equal(t, 15, got.activeSlot)
+}
+
+func TestDayModel_SlotDuration(t *testing.T) {
+	// Setup
+	tm := NewDay(newDate(2024, time.October, 2))
+
+	// Test
+	got := tm.SlotDuration(time.Hour)
+
+	// Assertions
+	assert.Equal(t, time.Hour, got.grid.slotDuration)
+	assert.Equal(t, 13, got.Slots())
+
+	// Test
+	got = tm.SlotDuration(0)
+
+	// Assertions
+	assert.Equal(t, 30*time.Minute, got.grid.slotDuration)
+}
+
+func TestDayModel_Update(t *testing.T) {
+	tests := []struct {
+		name           string
+		activeSlot     int
+		offset         int
+		msgs           []tea.Msg
+		wantActiveSlot int
+		wantOffset     int
+		wantMsgs       []tea.Msg
+	}{
+		{
+			name:       "first-down",
+			activeSlot: -1,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+			},
+			wantActiveSlot: 0,
+			wantOffset:     0,
+			wantMsgs: []tea.Msg{
+				ActiveSlotMsg{Start: newTime(2024, time.October, 2, 7, 0), End: newTime(2024, time.October, 2, 7, 30)},
+			},
+		},
+		{
+			name:       "first-up-scrolled",
+			activeSlot: -1,
+			offset:     4,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyUp},
+			},
+			wantActiveSlot: 4,
+			wantOffset:     4,
+			wantMsgs: []tea.Msg{
+				ActiveSlotMsg{Start: newTime(2024, time.October, 2, 9, 0), End: newTime(2024, time.October, 2, 9, 30)},
+			},
+		},
+		{
+			name:       "down-scrolls",
+			activeSlot: 3,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+			},
+			wantActiveSlot: 4,
+			wantOffset:     1,
+			wantMsgs: []tea.Msg{
+				ActiveSlotMsg{Start: newTime(2024, time.October, 2, 9, 0), End: newTime(2024, time.October, 2, 9, 30)},
+			},
+		},
+		{
+			name:       "up-lower-bound",
+			activeSlot: 0,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyUp},
+			},
+			wantActiveSlot: 0,
+			wantOffset:     0,
+			wantMsgs:       nil,
+		},
+		{
+			name:       "down-upper-bound",
+			activeSlot: 25,
+			offset:     22,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+			},
+			wantActiveSlot: 25,
+			wantOffset:     22,
+			wantMsgs:       nil,
+		},
+		{
+			name:       "scroll-down",
+			activeSlot: -1,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantActiveSlot: -1,
+			wantOffset:     4,
+			wantMsgs:       nil,
+		},
+		{
+			name:       "scroll-upper-bound",
+			activeSlot: -1,
+			offset:     21,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantActiveSlot: -1,
+			wantOffset:     22,
+			wantMsgs:       nil,
+		},
+		{
+			name:       "scroll-up-lower-bound",
+			activeSlot: -1,
+			offset:     1,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgUp},
+			},
+			wantActiveSlot: -1,
+			wantOffset:     0,
+			wantMsgs:       nil,
+		},
+		{
+			name:       "select",
+			activeSlot: 2,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantActiveSlot: 2,
+			wantOffset:     0,
+			wantMsgs: []tea.Msg{
+				SlotSelectedMsg{Start: newTime(2024, time.October, 2, 8, 0), End: newTime(2024, time.October, 2, 8, 30)},
+			},
+		},
+		{
+			name:       "select-uninitialized",
+			activeSlot: -1,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantActiveSlot: -1,
+			wantOffset:     0,
+			wantMsgs:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewDay(newDate(2024, time.October, 2)).Height(4)
+			tm.activeSlot = tt.activeSlot
+			tm.offset = tt.offset
+			_ = tm.Init()
+
+			// Test
+			var gotMsgs []tea.Msg
+			for _, msg := range tt.msgs {
+				gotModel, gotCmd := tm.Update(msg)
+				tm = gotModel.(DayModel)
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+			}
+
+			// Assertions
+			assert.Equal(t, tt.wantActiveSlot, tm.activeSlot)
+			assert.Equal(t, tt.wantOffset, tm.offset)
+			assert.Equal(t, tt.wantMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestDayModel_Update_EmptyTimeRange(t *testing.T) {
+	// Setup
+	tm := NewDay(newDate(2024, time.October, 2)).TimeRange(9*time.Hour, 9*time.Hour)
+
+	// Test
+	gotUp, gotUpCmd := tm.Update(tea.KeyMsg{Type: tea.KeyUp})
+	gotDown, gotDownCmd := tm.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	// Assertions
+	assert.Equal(t, 0, tm.Slots())
+	assert.Equal(t, -1, gotUp.(DayModel).activeSlot)
+	assert.Nil(t, gotUpCmd)
+	assert.Equal(t, -1, gotDown.(DayModel).activeSlot)
+	assert.Nil(t, gotDownCmd)
+}
+
+func TestDayModel_SlotStart_DaylightSaving(t *testing.T) {
+	loc := mustLoadLocation("America/New_York")
+
+	tests := []struct {
+		name string
+		date time.Time
+	}{
+		{name: "spring-forward", date: time.Date(2024, time.March, 10, 0, 0, 0, 0, loc)},
+		{name: "fall-back", date: time.Date(2024, time.November, 3, 0, 0, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewDay(tt.date)
+			y, m, d := tt.date.Date()
+
+			// Test
+			gotFirst := tm.SlotStart(0)
+			gotLater := tm.SlotStart(5)
+
+			// Assertions
+			assert.Equal(t, time.Date(y, m, d, 7, 0, 0, 0, loc), gotFirst)
+			assert.Equal(t, time.Date(y, m, d, 9, 30, 0, 0, loc), gotLater)
+		})
+	}
+}
+
+func TestDayModel_Update_Appointments(t *testing.T) {
+	// Setup
+	tm := NewDay(newDate(2024, time.October, 2))
+	appointments := []Appointment{
+		{
+			Title: "Core Hours",
+			Start: newTime(2024, time.October, 2, 9, 0),
+			End:   newTime(2024, time.October, 2, 10, 30),
+		},
+	}
+
+	// Test
+	got, gotCmd := tm.Update(AppointmentsMsg{Date: newDate(2024, time.October, 3), Appointments: appointments})
+
+	// Assertions
+	assert.Nil(t, gotCmd)
+	assert.Empty(t, got.(DayModel).appointments)
+
+	// Test
+	got, gotCmd = tm.Update(AppointmentsMsg{Date: newTime(2024, time.October, 2, 9, 0), Appointments: appointments})
+
+	// Assertions
+	assert.Nil(t, gotCmd)
+	assert.Equal(t, appointments, got.(DayModel).appointments)
+}
+
+func TestDayModel_View(t *testing.T) {
+	date := newDate(2024, time.October, 2)
+	appointments := []Appointment{
+		{
+			Title: "Core Hours",
+			Start: newTime(2024, time.October, 2, 9, 0),
+			End:   newTime(2024, time.October, 2, 10, 30),
+		},
+		{
+			Title: "Standup with the whole product team",
+			Start: newTime(2024, time.October, 2, 11, 15),
+			End:   newTime(2024, time.October, 2, 11, 45),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		model  DayModel
+		offset int
+	}{
+		{
+			name:  "full-day",
+			model: NewDay(date).TimeRange(8*time.Hour, 13*time.Hour),
+		},
+		{
+			name:  "hourly-slots",
+			model: NewDay(date).TimeRange(8*time.Hour, 13*time.Hour).SlotDuration(time.Hour),
+		},
+		{
+			name:   "scrolled",
+			model:  NewDay(date).TimeRange(8*time.Hour, 13*time.Hour).Height(4),
+			offset: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := tt.model.Appointments(appointments...)
+			_ = tm.Init()
+			tm.offset = tt.offset
+			tm.activeSlot = 3
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestDayModel_View_DaylightSaving(t *testing.T) {
+	// Setup
+	loc := mustLoadLocation("America/New_York")
+	tm := NewDay(time.Date(2024, time.March, 10, 0, 0, 0, 0, loc)).
+		TimeRange(8*time.Hour, 11*time.Hour).
+		Appointments(Appointment{
+			Title: "Brunch",
+			Start: time.Date(2024, time.March, 10, 9, 0, 0, 0, loc),
+			End:   time.Date(2024, time.March, 10, 10, 0, 0, 0, loc),
+		})
+	_ = tm.Init()
+
+	// Test
+	got := ansi.Strip(tm.View())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
@@ -0,0 +1,252 @@
+package calendar
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Event is an item on a calendar, such as a meeting or a holiday.
+type Event struct {
+	// UID identifies the event across calendars, as in iCalendar. It may be empty.
+	UID string
+
+	// Title to display
+	Title string
+
+	// Location of the event, e.g. a meeting room or an address
+	Location string
+
+	// Start of the event
+	Start time.Time
+
+	// End of the event, which is exclusive. For all-day events, this is the date after the last day of the event, as
+	// in iCalendar. If End is not after Start, the event lasts a single day, or a single instant if it is not all-day.
+	End time.Time
+
+	// AllDay marks events that span whole days, in which case only the calendar dates of Start and End are used
+	AllDay bool
+
+	// Category of the event, which selects a style from EventStyles.CategoryStyles
+	Category string
+
+	// Color of the event, which overrides the foreground color of its style if set
+	Color gloss.TerminalColor
+
+	// Recurrence describes how the event repeats, or is nil if the event does not repeat
+	Recurrence *Recurrence
+
+	// Payload holds arbitrary data for the application, e.g. an ID in an external system
+	Payload any
+}
+
+// Overlaps determines if any part of the event falls within the time range from start, inclusive, to end, exclusive.
+//
+// The dates of all-day events are interpreted in the location of start.
+func (e Event) Overlaps(start time.Time, end time.Time) bool {
+	eventStart, eventEnd := e.span(start.Location())
+	if eventEnd.Equal(eventStart) {
+		return !eventStart.Before(start) && eventStart.Before(end)
+	}
+	return eventStart.Before(end) && eventEnd.After(start)
+}
+
+// span calculates the start and end of the event as instants, with all-day events spanning whole days in the location.
+func (e Event) span(loc *time.Location) (time.Time, time.Time) {
+	if !e.AllDay {
+		return e.Start, laterTime(e.Start, e.End)
+	}
+
+	start := sameDateIn(e.Start, loc)
+	end := sameDateIn(e.End, loc)
+	if !end.After(start) {
+		end = start.AddDate(0, 0, 1)
+	}
+	return start, end
+}
+
+// appointment converts a timed event to an appointment that may be placed on a time grid.
+func (e Event) appointment() Appointment {
+	return Appointment{
+		Title: e.Title,
+		Start: e.Start,
+		End:   laterTime(e.Start, e.End),
+	}
+}
+
+// laterTime returns the later of two times.
+func laterTime(a time.Time, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// EventSource provides the events shown on a calendar. Calendars query the source for the range of dates they show
+// each time they are rendered.
+type EventSource interface {
+	// Events returns the events that overlap the time range from start, inclusive, to end, exclusive.
+	Events(start time.Time, end time.Time) []Event
+}
+
+// EventList is an EventSource that holds its events in memory.
+type EventList []Event
+
+// Events returns the events that overlap the time range from start, inclusive, to end, exclusive. Recurring events are
+// expanded into their occurrences within the range.
+func (l EventList) Events(start time.Time, end time.Time) []Event {
+	var events []Event
+	for _, e := range l {
+		events = append(events, e.Occurrences(start, end)...)
+	}
+	return events
+}
+
+// RecurringEventSource is an EventSource that can also provide recurring events unexpanded, e.g. so that they are
+// written by WriteICS as a single event with its recurrence rather than as unrelated copies of the event.
+type RecurringEventSource interface {
+	EventSource
+
+	// MasterEvents returns the events that have an occurrence overlapping the time range from start, inclusive, to end,
+	// exclusive. Recurring events are returned once, with their recurrence.
+	MasterEvents(start time.Time, end time.Time) []Event
+}
+
+// MasterEvents returns the events that have an occurrence overlapping the time range from start, inclusive, to end,
+// exclusive. Recurring events are returned once, with their recurrence.
+func (l EventList) MasterEvents(start time.Time, end time.Time) []Event {
+	var events []Event
+	for _, e := range l {
+		if len(e.Occurrences(start, end)) > 0 {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// visibleEvents queries a source for the events of a time range as they are to be exported, which keeps recurring
+// events unexpanded if the source supports it.
+func visibleEvents(source EventSource, start time.Time, end time.Time) []Event {
+	if source == nil {
+		return nil
+	}
+	if source, ok := source.(RecurringEventSource); ok {
+		return source.MasterEvents(start, end)
+	}
+	return source.Events(start, end)
+}
+
+// eventsByDate queries a source for the events overlapping a number of days from the first date, and groups them by
+// the dates they overlap. The events of each date are ordered with all-day events first, then by start time.
+func eventsByDate(source EventSource, first time.Time, days int) map[time.Time][]Event {
+	if source == nil {
+		return nil
+	}
+
+	return groupByDate(source.Events(first, first.AddDate(0, 0, days)), first, days)
+}
+
+// groupByDate groups events by the dates they overlap, for a number of days from the first date. The events of each
+// date are ordered with all-day events first, then by start time.
+func groupByDate(events []Event, first time.Time, days int) map[time.Time][]Event {
+	dates := make(map[time.Time][]Event)
+	for i := 0; i < days; i++ {
+		date := first.AddDate(0, 0, i)
+		for _, e := range events {
+			if e.Overlaps(date, date.AddDate(0, 0, 1)) {
+				dates[date] = append(dates[date], e)
+			}
+		}
+	}
+	for _, events := range dates {
+		slices.SortStableFunc(events, compareEvents)
+	}
+
+	return dates
+}
+
+// compareEvents orders all-day events before timed events, and then events by start time.
+func compareEvents(a Event, b Event) int {
+	if a.AllDay != b.AllDay {
+		if a.AllDay {
+			return -1
+		}
+		return 1
+	}
+	return a.Start.Compare(b.Start)
+}
+
+// EventRenderer renders the events on a date into a block of the given width and height, for use as the body of a
+// date. Events are ordered with all-day events first, then by start time.
+type EventRenderer func(date time.Time, events []Event, width int, height int, styles EventStyles) string
+
+// EventListRenderer renders one line per event, with the start time before the title of timed events that start on
+// the date. Events that do not fit within the height are omitted.
+func EventListRenderer(date time.Time, events []Event, width int, height int, styles EventStyles) string {
+	var lines []string
+	for _, e := range events {
+		if len(lines) >= height {
+			break
+		}
+
+		// Timed events continuing from a previous date have no start time to show
+		var b strings.Builder
+		if !e.AllDay && !e.Start.Before(date) {
+			b.WriteString(styles.TimeStyle.Render(e.Start.In(date.Location()).Format(styles.TimeFormat)))
+			b.WriteString(" ")
+		}
+		b.WriteString(styles.style(e).Render(e.Title))
+
+		lines = append(lines, ansi.Truncate(b.String(), width, "…"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// EventDotRenderer renders a dot per event, in the event's style, which suits dates too narrow to show titles. Dots
+// that do not fit within the width are replaced with the number of remaining events.
+func EventDotRenderer(date time.Time, events []Event, width int, height int, styles EventStyles) string {
+	if height < 1 {
+		return ""
+	}
+
+	// Show as many dots as fit alongside the count of remaining events
+	dots := len(events)
+	more := ""
+	if dots > width {
+		dots = width
+		for (dots > 0) && (dots+len(fmt.Sprintf("+%d", len(events)-dots)) > width) {
+			dots--
+		}
+		more = fmt.Sprintf("+%d", len(events)-dots)
+	}
+
+	var b strings.Builder
+	for _, e := range events[:dots] {
+		b.WriteString(styles.style(e).Render("•"))
+	}
+	if more != "" {
+		b.WriteString(styles.TimeStyle.Render(more))
+	}
+
+	return ansi.Truncate(b.String(), width, "")
+}
+
+// style determines the style of an event from its all-day flag, category and color.
+func (s EventStyles) style(e Event) gloss.Style {
+	style := s.EventStyle
+	if e.AllDay {
+		style = s.AllDayStyle
+	}
+	if categoryStyle, ok := s.CategoryStyles[e.Category]; ok {
+		style = categoryStyle.Inherit(style)
+	}
+	if e.Color != nil {
+		style = style.Foreground(e.Color)
+	}
+	return style
+}
@@ -0,0 +1,220 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_Overlaps(t *testing.T) {
+	start := newDate(2024, time.September, 10)
+	end := newDate(2024, time.September, 11)
+
+	tests := []struct {
+		name  string
+		event Event
+		want  bool
+	}{
+		{
+			name:  "within",
+			event: Event{Start: newTime(2024, time.September, 10, 9, 0), End: newTime(2024, time.September, 10, 10, 0)},
+			want:  true,
+		},
+		{
+			name:  "before",
+			event: Event{Start: newTime(2024, time.September, 9, 9, 0), End: newTime(2024, time.September, 10, 0, 0)},
+			want:  false,
+		},
+		{
+			name:  "spanning",
+			event: Event{Start: newTime(2024, time.September, 9, 9, 0), End: newTime(2024, time.September, 12, 9, 0)},
+			want:  true,
+		},
+		{
+			name:  "instant-at-start",
+			event: Event{Start: newTime(2024, time.September, 10, 0, 0)},
+			want:  true,
+		},
+		{
+			name:  "instant-at-end",
+			event: Event{Start: newTime(2024, time.September, 11, 0, 0)},
+			want:  false,
+		},
+		{
+			name:  "all-day",
+			event: Event{Start: newDate(2024, time.September, 10), AllDay: true},
+			want:  true,
+		},
+		{
+			name:  "all-day-ended",
+			event: Event{Start: newDate(2024, time.September, 8), End: newDate(2024, time.September, 10), AllDay: true},
+			want:  false,
+		},
+		{
+			name: "all-day-other-location",
+			event: Event{
+				Start:  time.Date(2024, time.September, 10, 0, 0, 0, 0, mustLoadLocation("Asia/Tokyo")),
+				AllDay: true,
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := tt.event.Overlaps(start, end)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventList_Events(t *testing.T) {
+	// Setup
+	list := EventList{
+		{Title: "Standup", Start: newTime(2024, time.September, 10, 9, 0), End: newTime(2024, time.September, 10, 9, 15)},
+		{Title: "Retro", Start: newTime(2024, time.September, 20, 9, 0), End: newTime(2024, time.September, 20, 10, 0)},
+	}
+
+	// Test
+	got := list.Events(newDate(2024, time.September, 8), newDate(2024, time.September, 15))
+
+	// Assertions
+	assert.Equal(t, EventList{list[0]}, EventList(got))
+}
+
+func Test_eventsByDate(t *testing.T) {
+	// Setup
+	standup := Event{Title: "Standup", Start: newTime(2024, time.September, 10, 9, 0), End: newTime(2024, time.September, 10, 9, 15)}
+	trip := Event{Title: "Trip", Start: newDate(2024, time.September, 9), End: newDate(2024, time.September, 11), AllDay: true}
+	list := EventList{standup, trip}
+
+	// Test
+	got := eventsByDate(list, newDate(2024, time.September, 8), 7)
+
+	// Assertions
+	assert.Equal(t, map[time.Time][]Event{
+		newDate(2024, time.September, 9):  {trip},
+		newDate(2024, time.September, 10): {trip, standup},
+	}, got)
+	assert.Nil(t, eventsByDate(nil, newDate(2024, time.September, 8), 7))
+}
+
+func TestEventListRenderer(t *testing.T) {
+	// Setup
+	date := newDate(2024, time.September, 10)
+	events := []Event{
+		{Title: "Trip", Start: newDate(2024, time.September, 9), AllDay: true},
+		{Title: "Overnight", Start: newTime(2024, time.September, 9, 22, 0), End: newTime(2024, time.September, 10, 2, 0)},
+		{Title: "Standup", Start: newTime(2024, time.September, 10, 9, 0)},
+		{Title: "Lunch", Start: newTime(2024, time.September, 10, 12, 0)},
+	}
+
+	// Test
+	got := ansi.Strip(EventListRenderer(date, events, 12, 3, DefaultEventStyles()))
+
+	// Assertions
+	assert.Equal(t, "Trip\nOvernight\n09:00 Stand…", got)
+}
+
+func TestEventDotRenderer(t *testing.T) {
+	tests := []struct {
+		name   string
+		events int
+		width  int
+		want   string
+	}{
+		{
+			name:   "fits",
+			events: 3,
+			width:  5,
+			want:   "•••",
+		},
+		{
+			name:   "overflow",
+			events: 7,
+			width:  5,
+			want:   "•••+4",
+		},
+		{
+			name:   "narrow",
+			events: 12,
+			width:  2,
+			want:   "+1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			events := make([]Event, tt.events)
+
+			// Test
+			got := ansi.Strip(EventDotRenderer(newDate(2024, time.September, 10), events, tt.width, 1, DefaultEventStyles()))
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func testEvents() EventList {
+	return EventList{
+		{Title: "Offsite", Start: newDate(2024, time.September, 9), End: newDate(2024, time.September, 11), AllDay: true},
+		{Title: "Standup", Start: newTime(2024, time.September, 10, 9, 0), End: newTime(2024, time.September, 10, 9, 30)},
+		{Title: "Review", Start: newTime(2024, time.September, 12, 10, 0), End: newTime(2024, time.September, 12, 11, 0)},
+	}
+}
+
+func TestMonthModel_View_Events(t *testing.T) {
+	// Setup
+	styles := DefaultMonthStyles()
+	styles.DateStyles.Width = 12
+	styles.DateStyles.Height = 3
+	styles.DateStyles.BodyStyle = styles.DateStyles.BodyStyle.Width(12).Height(2)
+	tm := NewMonth(2024, time.September).
+		Styles(styles).
+		Events(testEvents())
+
+	// Test
+	got := ansi.Strip(tm.ViewWeeks())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
+
+func TestWeekModel_View_Events(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeGrid bool
+	}{
+		{
+			name: "dates",
+		},
+		{
+			name:     "time-grid",
+			timeGrid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewWeek(newDate(2024, time.September, 10)).
+				TimeGrid(tt.timeGrid).
+				TimeRange(9*time.Hour, 11*time.Hour).
+				Events(testEvents())
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
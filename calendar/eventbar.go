@@ -0,0 +1,180 @@
+package calendar
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// eventBar is the part of a multi-day event drawn across a run of adjacent dates in a week row.
+type eventBar struct {
+	event Event
+
+	// dates covered by the bar, in order
+	dates []time.Time
+
+	// whether the event continues before the first date or after the last date of the bar
+	continuedBefore bool
+	continuedAfter  bool
+
+	// lane of the bar within the body of its dates
+	lane int
+}
+
+// eventBarCell is the part of an event bar drawn in a single date.
+type eventBarCell struct {
+	bar *eventBar
+
+	// index of the date within the dates of the bar
+	index int
+}
+
+// isMultiDay determines if an event covers more than one date in a location.
+func (e Event) isMultiDay(loc *time.Location) bool {
+	start, end := e.span(loc)
+	return !dateIn(start, loc).Equal(dateIn(end.Add(-time.Nanosecond), loc))
+}
+
+// layoutEventBars breaks multi-day events into bars across runs of adjacent dates, so that bars break at the start of
+// each week and at hidden weekdays. Each bar is placed in the lowest lane not taken by an overlapping bar in the same
+// week.
+//
+// The dates are the visible dates in order, and the bar cells of each date are returned by date.
+func layoutEventBars(events []Event, dates []time.Time, startOfWeek time.Weekday) map[time.Time][]eventBarCell {
+	// Group dates into runs of adjacent dates within a week
+	var runs [][]time.Time
+	for i, date := range dates {
+		adjacent := (i > 0) && dates[i-1].AddDate(0, 0, 1).Equal(date)
+		if !adjacent || (date.Weekday() == startOfWeek) {
+			runs = append(runs, nil)
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], date)
+	}
+
+	// Runs in the same week share lanes
+	weeks := make(map[time.Time][]*eventBar)
+	var weekOrder []time.Time
+	for _, run := range runs {
+		week := StartOfWeekContaining(run[0], startOfWeek)
+		if _, ok := weeks[week]; !ok {
+			weekOrder = append(weekOrder, week)
+			weeks[week] = nil
+		}
+
+		for _, e := range events {
+			var bar *eventBar
+			for _, date := range run {
+				if !e.Overlaps(date, date.AddDate(0, 0, 1)) {
+					bar = nil
+					continue
+				}
+				if bar == nil {
+					bar = &eventBar{event: e}
+					weeks[week] = append(weeks[week], bar)
+				}
+				bar.dates = append(bar.dates, date)
+			}
+		}
+	}
+
+	cells := make(map[time.Time][]eventBarCell)
+	for _, week := range weekOrder {
+		bars := weeks[week]
+
+		// Longer bars are placed first, so that they keep to the top lanes
+		slices.SortStableFunc(bars, func(a *eventBar, b *eventBar) int {
+			if c := a.dates[0].Compare(b.dates[0]); c != 0 {
+				return c
+			}
+			if len(a.dates) != len(b.dates) {
+				return len(b.dates) - len(a.dates)
+			}
+			return compareEvents(a.event, b.event)
+		})
+
+		var placed []*eventBar
+		for _, bar := range bars {
+			first, last := bar.dates[0], bar.dates[len(bar.dates)-1]
+			eventStart, eventEnd := bar.event.span(first.Location())
+			bar.continuedBefore = eventStart.Before(first)
+			bar.continuedAfter = eventEnd.After(last.AddDate(0, 0, 1))
+
+			for slices.ContainsFunc(placed, func(p *eventBar) bool {
+				return (p.lane == bar.lane) &&
+					!p.dates[0].After(last) &&
+					!p.dates[len(p.dates)-1].Before(first)
+			}) {
+				bar.lane++
+			}
+			placed = append(placed, bar)
+
+			for i, date := range bar.dates {
+				cells[date] = append(cells[date], eventBarCell{bar: bar, index: i})
+			}
+		}
+	}
+
+	return cells
+}
+
+// viewEventBars renders the lanes of event bars crossing a date, one line per lane up to the height. Lanes without a
+// bar on the date are left blank, so that each bar stays on the same line across its dates.
+func viewEventBars(cells []eventBarCell, width int, height int, styles EventStyles) []string {
+	lanes := 0
+	for _, c := range cells {
+		lanes = max(lanes, c.bar.lane+1)
+	}
+
+	lines := make([]string, min(lanes, height))
+	for i := range lines {
+		lines[i] = strings.Repeat(" ", width)
+	}
+	for _, c := range cells {
+		if c.bar.lane < len(lines) {
+			lines[c.bar.lane] = c.bar.view(c.index, width, styles)
+		}
+	}
+
+	return lines
+}
+
+// view renders the part of the bar drawn in one of its dates. The title is laid out across the whole bar, between
+// markers showing that the event continues beyond the bar, and cut into parts of the width of a date.
+func (b eventBar) view(index int, width int, styles EventStyles) string {
+	total := width * len(b.dates)
+
+	var before, after string
+	if b.continuedBefore {
+		before = "◂"
+	}
+	if b.continuedAfter {
+		after = "▸"
+	}
+	title := ansi.Truncate(b.event.Title, max(total-len([]rune(before))-len([]rune(after)), 0), "…")
+	text := before + title
+	text += strings.Repeat(" ", max(total-ansi.StringWidth(text)-ansi.StringWidth(after), 0)) + after
+
+	// Skip the parts of the preceding dates, moving wide characters split by a date's edge to the next date
+	var part string
+	for i := 0; i <= index; i++ {
+		part, text = cutWidth(text, width)
+	}
+
+	return styles.BarStyle.Inherit(styles.style(b.event)).Render(part)
+}
+
+// cutWidth splits text after the given number of cells, padding the first part with spaces if a wide character does
+// not fit.
+func cutWidth(text string, width int) (string, string) {
+	w := 0
+	for i, r := range text {
+		rw := ansi.StringWidth(string(r))
+		if w+rw > width {
+			return text[:i] + strings.Repeat(" ", width-w), text[i:]
+		}
+		w += rw
+	}
+	return text + strings.Repeat(" ", width-w), ""
+}
@@ -0,0 +1,179 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMultiDayEvents provides overlapping multi-day events around the weeks of September 2024.
+func testMultiDayEvents() EventList {
+	return EventList{
+		{Title: "Conference", Start: newDate(2024, time.September, 5), End: newDate(2024, time.September, 10), AllDay: true},
+		{Title: "On-call", Start: newDate(2024, time.August, 28), End: newDate(2024, time.September, 4), AllDay: true},
+		{Title: "Trip", Start: newDate(2024, time.September, 9), End: newDate(2024, time.September, 11), AllDay: true},
+		{Title: "Night shift", Start: newTime(2024, time.September, 18, 22, 0), End: newTime(2024, time.September, 19, 6, 0)},
+		{Title: "Vacation", Start: newDate(2024, time.September, 27), End: newDate(2024, time.October, 5), AllDay: true},
+		{Title: "Review", Start: newTime(2024, time.September, 9, 10, 0), End: newTime(2024, time.September, 9, 11, 0)},
+	}
+}
+
+func TestEvent_isMultiDay(t *testing.T) {
+	tests := []struct {
+		name  string
+		event Event
+		want  bool
+	}{
+		{
+			name:  "single-all-day",
+			event: Event{Start: newDate(2024, time.September, 9), AllDay: true},
+		},
+		{
+			name:  "single-all-day-with-end",
+			event: Event{Start: newDate(2024, time.September, 9), End: newDate(2024, time.September, 10), AllDay: true},
+		},
+		{
+			name:  "two-all-day",
+			event: Event{Start: newDate(2024, time.September, 9), End: newDate(2024, time.September, 11), AllDay: true},
+			want:  true,
+		},
+		{
+			name:  "timed-until-midnight",
+			event: Event{Start: newTime(2024, time.September, 9, 22, 0), End: newDate(2024, time.September, 10)},
+		},
+		{
+			name:  "timed-past-midnight",
+			event: Event{Start: newTime(2024, time.September, 9, 22, 0), End: newTime(2024, time.September, 10, 1, 0)},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := tt.event.isMultiDay(time.UTC)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_layoutEventBars(t *testing.T) {
+	// Setup
+	var dates []time.Time
+	for d := 1; d <= 14; d++ {
+		// Weekends are hidden
+		if date := newDate(2024, time.September, d); !isWeekend(date) {
+			dates = append(dates, date)
+		}
+	}
+	events := []Event{
+		{Title: "Conference", Start: newDate(2024, time.September, 5), End: newDate(2024, time.September, 10), AllDay: true},
+		{Title: "Trip", Start: newDate(2024, time.September, 9), End: newDate(2024, time.September, 11), AllDay: true},
+	}
+
+	// Test
+	got := layoutEventBars(events, dates, time.Sunday)
+
+	// Assertions
+	thursday := got[newDate(2024, time.September, 5)]
+	if assert.Len(t, thursday, 1) {
+		assert.Equal(t, "Conference", thursday[0].bar.event.Title)
+		assert.Equal(t, 0, thursday[0].index)
+		assert.Equal(
+			t,
+			[]time.Time{newDate(2024, time.September, 5), newDate(2024, time.September, 6)},
+			thursday[0].bar.dates,
+		)
+		assert.False(t, thursday[0].bar.continuedBefore)
+		assert.True(t, thursday[0].bar.continuedAfter)
+	}
+
+	// The bar breaks at the hidden weekend and the start of the week, and the longer overlapping trip takes the first lane
+	monday := got[newDate(2024, time.September, 9)]
+	if assert.Len(t, monday, 2) {
+		assert.Equal(t, "Trip", monday[0].bar.event.Title)
+		assert.Equal(t, 0, monday[0].bar.lane)
+		assert.Equal(t, "Conference", monday[1].bar.event.Title)
+		assert.Equal(t, 1, monday[1].bar.lane)
+		assert.True(t, monday[1].bar.continuedBefore)
+		assert.False(t, monday[1].bar.continuedAfter)
+	}
+	tuesday := got[newDate(2024, time.September, 10)]
+	if assert.Len(t, tuesday, 1) {
+		assert.Equal(t, 0, tuesday[0].bar.lane)
+		assert.Equal(t, 1, tuesday[0].index)
+	}
+	assert.Empty(t, got[newDate(2024, time.September, 11)])
+}
+
+func Test_cutWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		wantPart string
+		wantRest string
+	}{
+		{name: "fits", text: "abc", width: 5, wantPart: "abc  ", wantRest: ""},
+		{name: "cut", text: "abcdef", width: 4, wantPart: "abcd", wantRest: "ef"},
+		{name: "wide-at-edge", text: "a週b", width: 2, wantPart: "a ", wantRest: "週b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			gotPart, gotRest := cutWidth(tt.text, tt.width)
+
+			// Assertions
+			assert.Equal(t, tt.wantPart, gotPart)
+			assert.Equal(t, tt.wantRest, gotRest)
+		})
+	}
+}
+
+func TestMonthModel_View_EventBars(t *testing.T) {
+	tests := []struct {
+		name     string
+		weekdays Weekdays
+	}{
+		{
+			name:     "all-weekdays",
+			weekdays: DefaultWeekdays(),
+		},
+		{
+			name: "hidden-weekend",
+			weekdays: Weekdays{
+				time.Monday:    "M",
+				time.Tuesday:   "T",
+				time.Wednesday: "W",
+				time.Thursday:  "T",
+				time.Friday:    "F",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			styles := DefaultMonthStyles()
+			styles.DateStyles.Width = 8
+			styles.DateStyles.Height = 4
+			styles.DateStyles.BodyStyle = styles.DateStyles.BodyStyle.Width(8).Height(3)
+			tm := NewMonth(2024, time.September).
+				Weekdays(tt.weekdays).
+				Styles(styles).
+				Events(testMultiDayEvents())
+
+			// Test
+			got := ansi.Strip(tm.ViewWeeks())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
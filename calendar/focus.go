@@ -0,0 +1,68 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+)
+
+// DayFocusedMsg notifies to other models that key presses are now routed to the content of a date.
+type DayFocusedMsg struct {
+	// The date whose content has focus
+	Date time.Time
+}
+
+// DayBlurredMsg notifies to other models that key presses are no longer routed to the content of a date.
+type DayBlurredMsg struct {
+	// The date whose content lost focus
+	Date time.Time
+}
+
+// updateFocus handles the EnterDay and ExitDay bindings, and routes other key presses to the content of the focused
+// date. The focused date is zero while no content has focus. Handled is false for key presses left to the calendar.
+func updateFocus(
+	msg tea.KeyMsg,
+	keyMap KeyMap,
+	days map[time.Time]tea.Model,
+	active time.Time,
+	focused time.Time,
+) (newFocused time.Time, handled bool, cmd tea.Cmd) {
+	if focused == (time.Time{}) {
+		// Only dates with content may be entered
+		if _, ok := days[active]; !ok || !key.Matches(msg, keyMap.EnterDay) {
+			return time.Time{}, false, nil
+		}
+		return active, true, func() tea.Msg {
+			return DayFocusedMsg{
+				Date: active,
+			}
+		}
+	}
+
+	content, ok := days[focused]
+	if !ok || key.Matches(msg, keyMap.ExitDay) {
+		return time.Time{}, true, blurred(focused)
+	}
+
+	days[focused], cmd = content.Update(msg)
+	return focused, true, cmd
+}
+
+// blurred notifies that the content of a date lost focus.
+func blurred(date time.Time) tea.Cmd {
+	return func() tea.Msg {
+		return DayBlurredMsg{
+			Date: date,
+		}
+	}
+}
+
+// focusedStyle applies the focused style over a body style. Padding is not inherited by lipgloss, so it is copied.
+func focusedStyle(styles DateStyles, body gloss.Style) gloss.Style {
+	top, right, bottom, left := body.GetPadding()
+	return styles.FocusedStyle.
+		Inherit(body).
+		Padding(top, right, bottom, left)
+}
@@ -0,0 +1,156 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+// testInputModel is day content that records the runes typed into it.
+type testInputModel struct {
+	text string
+}
+
+func (m testInputModel) Init() tea.Cmd { return nil }
+func (m testInputModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		m.text += msg.String()
+	}
+	return m, nil
+}
+func (m testInputModel) View() string { return m.text }
+
+// keys creates a key press per rune.
+func keys(runes string) []tea.KeyMsg {
+	var msgs []tea.KeyMsg
+	for _, r := range runes {
+		msgs = append(msgs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return msgs
+}
+
+func TestMonthModel_Update_Focus(t *testing.T) {
+	// Setup
+	date := newDate(2024, time.September, 18)
+	tm := NewMonth(2024, time.September)
+	tm.activeDay = 18
+	tm.days[date] = testInputModel{}
+
+	// Test
+	got, gotFocusCmd := tm.Update(keys("e")[0])
+	var gotTypedMsgs []tea.Msg
+	for _, msg := range append(keys("abc"), tea.KeyMsg{Type: tea.KeyRight}) {
+		var cmd tea.Cmd
+		got, cmd = got.(MonthModel).Update(msg)
+		gotTypedMsgs = append(gotTypedMsgs, collectMsgs(cmd)...)
+	}
+	gotFocused := got.(MonthModel).FocusedDate()
+	got, gotBlurCmd := got.(MonthModel).Update(tea.KeyMsg{Type: tea.KeyCtrlX})
+	got, gotMoveCmd := got.(MonthModel).Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	// Assertions
+	assert.Equal(t, []tea.Msg{DayFocusedMsg{Date: date}}, collectMsgs(gotFocusCmd))
+	assert.Empty(t, gotTypedMsgs)
+	assert.Equal(t, date, gotFocused)
+	assert.Equal(t, "abcright", got.(MonthModel).days[date].View())
+	assert.Equal(t, []tea.Msg{DayBlurredMsg{Date: date}}, collectMsgs(gotBlurCmd))
+	assert.Equal(t, []tea.Msg{ActiveDateMsg{Date: date.AddDate(0, 0, 1)}}, collectMsgs(gotMoveCmd))
+	assert.Equal(t, time.Time{}, got.(MonthModel).FocusedDate())
+}
+
+func TestMonthModel_Update_FocusCancel(t *testing.T) {
+	// Setup
+	date := newDate(2024, time.September, 18)
+	tm := NewMonth(2024, time.September).Selection(SelectionRange)
+	tm.activeDay = 18
+	tm.days[date] = testInputModel{}
+
+	// Test
+	got, _ := tm.Update(keys("v")[0])
+	got, _ = got.(MonthModel).Update(keys("e")[0])
+	got, gotFocusedCmd := got.(MonthModel).Update(tea.KeyMsg{Type: tea.KeyEsc})
+	gotFocused := got.(MonthModel)
+	got, _ = got.(MonthModel).Update(tea.KeyMsg{Type: tea.KeyCtrlX})
+	got, _ = got.(MonthModel).Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Assertions
+	assert.Empty(t, collectMsgs(gotFocusedCmd))
+	assert.Equal(t, date, gotFocused.FocusedDate())
+	assert.Equal(t, "esc", gotFocused.days[date].View())
+	assert.NotEqual(t, time.Time{}, gotFocused.selection.anchor)
+	assert.Equal(t, time.Time{}, got.(MonthModel).FocusedDate())
+	assert.Equal(t, time.Time{}, got.(MonthModel).selection.anchor)
+}
+
+func TestMonthModel_Update_FocusWithoutContent(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September)
+	tm.activeDay = 18
+
+	// Test
+	got, gotCmd := tm.Update(keys("e")[0])
+
+	// Assertions
+	assert.Empty(t, collectMsgs(gotCmd))
+	assert.Equal(t, time.Time{}, got.(MonthModel).FocusedDate())
+}
+
+func TestMonthModel_Update_FocusClickAway(t *testing.T) {
+	// Setup
+	date := newDate(2024, time.September, 17)
+	tm := NewMonth(2024, time.September)
+	tm.activeDay = 17
+	tm.days[date] = testInputModel{}
+
+	// Test
+	got, _ := tm.Update(keys("e")[0])
+	got, gotCmd := got.(MonthModel).Update(click(20, 9))
+
+	// Assertions
+	assert.Equal(t, []tea.Msg{
+		DayBlurredMsg{Date: date},
+		ActiveDateMsg{Date: newDate(2024, time.September, 18)},
+	}, collectMsgs(gotCmd))
+	assert.Equal(t, time.Time{}, got.(MonthModel).FocusedDate())
+}
+
+func TestWeekModel_Update_Focus(t *testing.T) {
+	// Setup
+	date := newDate(2024, time.September, 18)
+	tm := NewWeek(date)
+	tm.activeDate = date
+	tm.days[date] = testInputModel{}
+
+	// Test
+	got, gotFocusCmd := tm.Update(keys("e")[0])
+	for _, msg := range keys("hi") {
+		got, _ = got.(WeekModel).Update(msg)
+	}
+	gotFocused := got.(WeekModel).FocusedDate()
+	got, gotBlurCmd := got.(WeekModel).Update(tea.KeyMsg{Type: tea.KeyCtrlX})
+
+	// Assertions
+	assert.Equal(t, []tea.Msg{DayFocusedMsg{Date: date}}, collectMsgs(gotFocusCmd))
+	assert.Equal(t, date, gotFocused)
+	assert.Equal(t, "hi", got.(WeekModel).days[date].View())
+	assert.Equal(t, []tea.Msg{DayBlurredMsg{Date: date}}, collectMsgs(gotBlurCmd))
+	assert.Equal(t, date, got.(WeekModel).activeDate)
+}
+
+func Test_focusedStyle(t *testing.T) {
+	// Setup
+	styles := DefaultDateStyles()
+	body := styles.BodyStyle.Padding(0, 1)
+
+	// Test
+	got := focusedStyle(styles, body)
+
+	// Assertions
+	assert.Equal(t, body.GetWidth(), got.GetWidth())
+	assert.Equal(t, body.GetHeight(), got.GetHeight())
+	assert.Equal(t, 1, got.GetPaddingLeft())
+	assert.Equal(t, gloss.TerminalColor(DefaultSelectedColor), got.GetBackground())
+}
@@ -0,0 +1,445 @@
+package calendar
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+)
+
+// HeatmapChangedMsg notifies to other models that the HeatmapModel now represents a different range of dates.
+type HeatmapChangedMsg struct {
+	// First date of the range now represented
+	Start time.Time
+
+	// Last date of the range now represented
+	End time.Time
+}
+
+// HeatmapScale maps the values of dates to colors.
+type HeatmapScale struct {
+	// Colors from lowest to highest. The first color is used for dates without a value, or with a value of zero or
+	// less.
+	Colors []gloss.TerminalColor
+
+	// Thresholds are the lowest values of each color after the first, in ascending order. If empty, positive values
+	// are spread evenly over the colors after the first, up to the highest value shown.
+	Thresholds []float64
+}
+
+// DefaultHeatmapScale provides a scale of greens, as on contribution graphs.
+func DefaultHeatmapScale() HeatmapScale {
+	return HeatmapScale{
+		Colors: []gloss.TerminalColor{
+			gloss.AdaptiveColor{Light: "#EBEDF0", Dark: "#161B22"},
+			gloss.AdaptiveColor{Light: "#9BE9A8", Dark: "#0E4429"},
+			gloss.AdaptiveColor{Light: "#40C463", Dark: "#006D32"},
+			gloss.AdaptiveColor{Light: "#30A14E", Dark: "#26A641"},
+			gloss.AdaptiveColor{Light: "#216E39", Dark: "#39D353"},
+		},
+	}
+}
+
+// level determines the index of the color for a value, given the highest value shown.
+func (s HeatmapScale) level(value float64, highest float64) int {
+	if (len(s.Colors) < 2) || (value <= 0) {
+		return 0
+	}
+
+	if len(s.Thresholds) > 0 {
+		level := 0
+		for i, threshold := range s.Thresholds {
+			if value >= threshold {
+				level = i + 1
+			}
+		}
+		return min(level, len(s.Colors)-1)
+	}
+
+	levels := len(s.Colors) - 1
+	return max(1, min(levels, int(math.Ceil(value/highest*float64(levels)))))
+}
+
+// HeatmapModel represents a range of dates as a compact grid of cells, one column per week and one row per weekday,
+// colored by a value per date, e.g. the number of contributions or exercises on each date.
+type HeatmapModel struct {
+	// keyMap is key bindings for heatmap navigation
+	keyMap KeyMap
+
+	// startOfWeek is the day that represents the beginning of the week
+	startOfWeek time.Weekday
+
+	// weekdays manages the row labels, and which weekdays are shown as rows
+	weekdays Weekdays
+
+	// locale provides month names
+	locale Locale
+
+	// year to represent, when not representing a number of weeks
+	year int
+	// weeks is the number of weeks to represent, ending with the week of end, or zero to represent the year
+	weeks int
+	end   time.Time
+
+	// values of dates, by date in UTC
+	values map[time.Time]float64
+
+	activeDate time.Time
+
+	// scale maps values to colors
+	scale HeatmapScale
+	// showLegend enables the legend below the grid
+	showLegend bool
+
+	// Styles
+	styles HeatmapStyles
+}
+
+// NewHeatmap creates a new HeatmapModel representing a year.
+func NewHeatmap(year int) HeatmapModel {
+	return HeatmapModel{
+		keyMap: DefaultHeatmapKeyMap(),
+
+		startOfWeek: time.Sunday,
+		weekdays:    DefaultWeekdays(),
+		locale:      DefaultLocale(),
+
+		year:   year,
+		values: make(map[time.Time]float64),
+
+		scale:      DefaultHeatmapScale(),
+		showLegend: true,
+
+		styles: DefaultHeatmapStyles(),
+	}
+}
+
+// NewHeatmapWeeks creates a new HeatmapModel representing a rolling number of weeks, ending with the date.
+func NewHeatmapWeeks(end time.Time, weeks int) HeatmapModel {
+	m := NewHeatmap(end.Year())
+	m.weeks = max(1, weeks)
+	m.end = sameDateIn(end, time.UTC)
+	return m
+}
+
+// StartOfWeek sets the first day of the week, which is the first row of the grid.
+func (m HeatmapModel) StartOfWeek(weekday time.Weekday) HeatmapModel {
+	m.startOfWeek = weekday
+	return m
+}
+
+// Weekdays sets the row labels. Weekdays without a label are not shown.
+func (m HeatmapModel) Weekdays(weekdays Weekdays) HeatmapModel {
+	m.weekdays = weekdays
+	return m
+}
+
+// Locale sets the month names, row labels and first day of the week.
+//
+// Row labels and the first day of the week may still be overridden afterwards with Weekdays and StartOfWeek.
+func (m HeatmapModel) Locale(locale Locale) HeatmapModel {
+	m.locale = locale
+	m.weekdays = locale.Weekdays
+	m.startOfWeek = locale.FirstDayOfWeek
+	return m
+}
+
+// Values sets the value of each date, replacing any existing values. Only the calendar dates of the keys are used, and
+// values of keys on the same date are added together.
+func (m HeatmapModel) Values(values map[time.Time]float64) HeatmapModel {
+	m.values = make(map[time.Time]float64, len(values))
+	for date, value := range values {
+		m.values[sameDateIn(date, time.UTC)] += value
+	}
+	return m
+}
+
+// Value returns the value of a date, and whether the date has a value.
+func (m HeatmapModel) Value(date time.Time) (float64, bool) {
+	value, ok := m.values[sameDateIn(date, time.UTC)]
+	return value, ok
+}
+
+// Scale sets the colors of values. Defaults to DefaultHeatmapScale.
+func (m HeatmapModel) Scale(scale HeatmapScale) HeatmapModel {
+	m.scale = scale
+	return m
+}
+
+// Legend enables or disables the legend of the scale below the grid. Defaults to enabled.
+func (m HeatmapModel) Legend(enabled bool) HeatmapModel {
+	m.showLegend = enabled
+	return m
+}
+
+// Styles sets custom styling.
+func (m HeatmapModel) Styles(styles HeatmapStyles) HeatmapModel {
+	m.styles = styles
+	return m
+}
+
+// ActiveDate returns the active date. If no date is active, the zero time is returned.
+func (m HeatmapModel) ActiveDate() time.Time {
+	return m.activeDate
+}
+
+// Range returns the first and last dates represented.
+func (m HeatmapModel) Range() (start time.Time, end time.Time) {
+	if m.weeks == 0 {
+		return time.Date(m.year, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(m.year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+	start = StartOfWeekContaining(m.end, m.startOfWeek).AddDate(0, 0, -7*(m.weeks-1))
+	return start, m.end
+}
+
+// PreviousPage moves to the previous year, or to the previous number of weeks.
+func (m HeatmapModel) PreviousPage() HeatmapModel {
+	return m.shiftPages(-1)
+}
+
+// NextPage moves to the next year, or to the next number of weeks.
+func (m HeatmapModel) NextPage() HeatmapModel {
+	return m.shiftPages(1)
+}
+
+// shiftPages moves the range by n years or n times the number of weeks, moving the active date along with it.
+func (m HeatmapModel) shiftPages(n int) HeatmapModel {
+	date := m.activeDate
+	if m.weeks == 0 {
+		m.year += n
+		date = date.AddDate(n, 0, 0)
+	} else {
+		m.end = m.end.AddDate(0, 0, 7*m.weeks*n)
+		date = date.AddDate(0, 0, 7*m.weeks*n)
+	}
+
+	if m.activeDate != (time.Time{}) {
+		// A year later the date may fall on a hidden weekday
+		if !m.weekdays.IsVisible(date.Weekday()) {
+			date = visibleDate(m.weekdays, date, 1)
+		}
+		m.activeDate = m.clamp(date)
+	}
+	return m
+}
+
+// clamp keeps a date within the range, on a visible weekday.
+func (m HeatmapModel) clamp(date time.Time) time.Time {
+	start, end := m.Range()
+	if date.Before(start) {
+		date = visibleDate(m.weekdays, start.AddDate(0, 0, -1), 1)
+	}
+	if date.After(end) {
+		date = visibleDate(m.weekdays, end.AddDate(0, 0, 1), -1)
+	}
+	return date
+}
+
+// setActiveDate sets the active date, moving the range by whole pages until it contains the date.
+func (m HeatmapModel) setActiveDate(date time.Time) HeatmapModel {
+	for i := 0; i < maxDateSearch; i++ {
+		start, end := m.Range()
+		switch {
+		case date.Before(start):
+			m = m.shiftPages(-1)
+		case date.After(end):
+			m = m.shiftPages(1)
+		default:
+			m.activeDate = date
+			return m
+		}
+	}
+	return m
+}
+
+// Init the HeatmapModel.
+func (m HeatmapModel) Init() tea.Cmd { return nil }
+
+// Update the HeatmapModel.
+func (m HeatmapModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		oldActiveDate := m.activeDate
+
+		// If initializing the active date, start from either edge of the range so that the first movement lands on
+		// the first or last visible day of the range.
+		start, end := m.Range()
+
+		switch {
+		case key.Matches(msg, m.keyMap.Up), key.Matches(msg, m.keyMap.Left):
+			if m.activeDate == (time.Time{}) {
+				m.activeDate = visibleDate(m.weekdays, end.AddDate(0, 0, 1), -1)
+				break
+			}
+			if key.Matches(msg, m.keyMap.Left) {
+				m = m.setActiveDate(m.activeDate.AddDate(0, 0, -7))
+				break
+			}
+			m = m.setActiveDate(visibleDate(m.weekdays, m.activeDate, -1))
+		case key.Matches(msg, m.keyMap.Down), key.Matches(msg, m.keyMap.Right):
+			if m.activeDate == (time.Time{}) {
+				m.activeDate = visibleDate(m.weekdays, start.AddDate(0, 0, -1), 1)
+				break
+			}
+			if key.Matches(msg, m.keyMap.Right) {
+				m = m.setActiveDate(m.activeDate.AddDate(0, 0, 7))
+				break
+			}
+			m = m.setActiveDate(visibleDate(m.weekdays, m.activeDate, 1))
+		case key.Matches(msg, m.keyMap.PreviousYear):
+			m = m.PreviousPage()
+		case key.Matches(msg, m.keyMap.NextYear):
+			m = m.NextPage()
+		}
+
+		if newStart, newEnd := m.Range(); !newStart.Equal(start) {
+			cmds = append(cmds, func() tea.Msg {
+				return HeatmapChangedMsg{
+					Start: newStart,
+					End:   newEnd,
+				}
+			})
+		}
+		if !oldActiveDate.Equal(m.activeDate) {
+			activeDate := m.activeDate
+			cmds = append(cmds, func() tea.Msg {
+				return ActiveDateMsg{
+					Date: activeDate,
+				}
+			})
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// View renders the HeatmapModel.
+func (m HeatmapModel) View() string {
+	view := m.ViewGrid()
+	if m.showLegend {
+		view = gloss.JoinVertical(gloss.Left, view, m.ViewLegend())
+	}
+	return view
+}
+
+// ViewGrid renders the month labels, the row labels and the grid of cells.
+func (m HeatmapModel) ViewGrid() string {
+	start, end := m.Range()
+	first := StartOfWeekContaining(start, m.startOfWeek)
+	columns := int(math.Round(end.Sub(first).Hours()/24))/7 + 1
+
+	// Row labels are aligned to the widest label
+	var rows []time.Weekday
+	labelWidth := 0
+	for i := 0; i < 7; i++ {
+		wd := time.Weekday((int(m.startOfWeek) + i) % 7)
+		if label, ok := m.weekdays.Get(wd); ok {
+			rows = append(rows, wd)
+			labelWidth = max(labelWidth, gloss.Width(label))
+		}
+	}
+	labelStyle := m.styles.RowLabelStyle.Width(labelWidth + m.styles.RowLabelStyle.GetHorizontalFrameSize())
+
+	highest := 0.0
+	for date, value := range m.values {
+		if !date.Before(start) && !date.After(end) {
+			highest = max(highest, value)
+		}
+	}
+
+	lines := []string{labelStyle.Render("") + m.viewMonthLabels(first, start, end, columns)}
+	for _, wd := range rows {
+		label, _ := m.weekdays.Get(wd)
+
+		var b strings.Builder
+		b.WriteString(labelStyle.Render(label))
+		for c := 0; c < columns; c++ {
+			date := first.AddDate(0, 0, 7*c+(int(wd)-int(m.startOfWeek)+7)%7)
+			b.WriteString(m.viewCell(date, start, end, highest))
+		}
+		lines = append(lines, b.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// viewMonthLabels renders the short name of each month above the column of the week in which it begins. Names that
+// would run into the next name are cut short.
+func (m HeatmapModel) viewMonthLabels(first time.Time, start time.Time, end time.Time, columns int) string {
+	width := columns * m.styles.CellWidth
+	line := []rune(strings.Repeat(" ", width))
+	next := width
+	for c := columns - 1; c >= 0; c-- {
+		week := first.AddDate(0, 0, 7*c)
+		monthStart := time.Date(week.Year(), week.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if week.Day() > 1 {
+			monthStart = monthStart.AddDate(0, 1, 0)
+		}
+		// Label the column containing the first of a month, or the first column for the month the range starts in
+		labeled := monthStart.Before(week.AddDate(0, 0, 7)) && !monthStart.After(end)
+		if !labeled && (c != 0) {
+			continue
+		}
+		if !labeled {
+			monthStart = start
+		}
+
+		pos := c * m.styles.CellWidth
+		name := []rune(m.locale.Format(monthStart, "Jan"))
+		n := copy(line[pos:min(next, width)], name)
+		if n > 0 {
+			next = pos
+		}
+	}
+
+	return m.styles.MonthLabelStyle.Render(string(line))
+}
+
+// viewCell renders the cell of a date, which is blank if the date is outside the range.
+func (m HeatmapModel) viewCell(date time.Time, start time.Time, end time.Time, highest float64) string {
+	style := m.styles.CellStyle.Width(m.styles.CellWidth)
+	if date.Before(start) || date.After(end) {
+		return style.Render("")
+	}
+
+	glyph := m.styles.Glyph
+	value, _ := m.Value(date)
+	if len(m.scale.Colors) > 0 {
+		style = style.Foreground(m.scale.Colors[m.scale.level(value, highest)])
+	}
+	if date.Equal(m.activeDate) {
+		style = m.styles.ActiveCellStyle.Inherit(style)
+		glyph = m.styles.ActiveGlyph
+	}
+
+	return style.Render(glyph)
+}
+
+// ViewLegend renders the colors of the scale from lowest to highest, between the legend labels.
+func (m HeatmapModel) ViewLegend() string {
+	parts := []string{m.styles.LegendStyle.Render(m.styles.LegendLess)}
+	for _, color := range m.scale.Colors {
+		parts = append(parts, m.styles.CellStyle.Foreground(color).Render(m.styles.Glyph))
+	}
+	parts = append(parts, m.styles.LegendStyle.Render(m.styles.LegendMore))
+
+	return strings.Join(parts, " ")
+}
+
+// Title generates a title for the heatmap that may be used during rendering: the year, or the first and last dates of
+// a number of weeks.
+func (m HeatmapModel) Title() string {
+	if m.weeks == 0 {
+		return fmt.Sprintf("%d", m.year)
+	}
+	start, end := m.Range()
+	layout := cmp.Or(m.locale.ShortDateFormat, LocaleEnUS().ShortDateFormat)
+	return m.locale.Format(start, layout) + " – " + m.locale.Format(end, layout)
+}
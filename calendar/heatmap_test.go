@@ -0,0 +1,264 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeatmapScale_level(t *testing.T) {
+	colors := []gloss.TerminalColor{gloss.Color("0"), gloss.Color("1"), gloss.Color("2"), gloss.Color("3")}
+	tests := []struct {
+		name    string
+		scale   HeatmapScale
+		value   float64
+		highest float64
+		want    int
+	}{
+		{name: "zero", scale: HeatmapScale{Colors: colors}, value: 0, highest: 9, want: 0},
+		{name: "negative", scale: HeatmapScale{Colors: colors}, value: -3, highest: 9, want: 0},
+		{name: "linear-small", scale: HeatmapScale{Colors: colors}, value: 0.5, highest: 9, want: 1},
+		{name: "linear-middle", scale: HeatmapScale{Colors: colors}, value: 4, highest: 9, want: 2},
+		{name: "linear-highest", scale: HeatmapScale{Colors: colors}, value: 9, highest: 9, want: 3},
+		{name: "thresholds-below", scale: HeatmapScale{Colors: colors, Thresholds: []float64{2, 5, 10}}, value: 1, want: 0},
+		{name: "thresholds-equal", scale: HeatmapScale{Colors: colors, Thresholds: []float64{2, 5, 10}}, value: 5, want: 2},
+		{name: "thresholds-above", scale: HeatmapScale{Colors: colors, Thresholds: []float64{2, 5, 10}}, value: 30, want: 3},
+		{name: "single-color", scale: HeatmapScale{Colors: colors[:1]}, value: 30, highest: 30, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := tt.scale.level(tt.value, tt.highest)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHeatmapModel_Values(t *testing.T) {
+	// Setup
+	tm := NewHeatmap(2024).Values(map[time.Time]float64{
+		newTime(2024, time.September, 2, 7, 0):  30,
+		newTime(2024, time.September, 2, 18, 0): 15,
+		newDate(2024, time.September, 3):        20,
+	})
+
+	// Test
+	got, gotOk := tm.Value(newDate(2024, time.September, 2))
+	_, gotNone := tm.Value(newDate(2024, time.September, 4))
+
+	// Assertions
+	assert.True(t, gotOk)
+	assert.Equal(t, 45.0, got)
+	assert.False(t, gotNone)
+}
+
+func TestHeatmapModel_Range(t *testing.T) {
+	tests := []struct {
+		name      string
+		tm        HeatmapModel
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "year",
+			tm:        NewHeatmap(2024),
+			wantStart: newDate(2024, time.January, 1),
+			wantEnd:   newDate(2024, time.December, 31),
+		},
+		{
+			name:      "weeks",
+			tm:        NewHeatmapWeeks(newTime(2024, time.September, 18, 12, 0), 4),
+			wantStart: newDate(2024, time.August, 25),
+			wantEnd:   newDate(2024, time.September, 18),
+		},
+		{
+			name:      "weeks-monday",
+			tm:        NewHeatmapWeeks(newDate(2024, time.September, 18), 4).StartOfWeek(time.Monday),
+			wantStart: newDate(2024, time.August, 26),
+			wantEnd:   newDate(2024, time.September, 18),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			gotStart, gotEnd := tt.tm.Range()
+
+			// Assertions
+			assert.Equal(t, tt.wantStart, gotStart)
+			assert.Equal(t, tt.wantEnd, gotEnd)
+		})
+	}
+}
+
+func TestHeatmapModel_Update(t *testing.T) {
+	weekdays := Weekdays{
+		time.Monday:    "Mon",
+		time.Tuesday:   "Tue",
+		time.Wednesday: "Wed",
+		time.Thursday:  "Thu",
+		time.Friday:    "Fri",
+	}
+	tests := []struct {
+		name     string
+		tm       HeatmapModel
+		active   time.Time
+		msg      tea.KeyMsg
+		want     time.Time
+		wantMsgs []tea.Msg
+	}{
+		{
+			name: "initialize-down",
+			tm:   NewHeatmap(2024),
+			msg:  tea.KeyMsg{Type: tea.KeyDown},
+			want: newDate(2024, time.January, 1),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.January, 1)},
+			},
+		},
+		{
+			name: "initialize-up",
+			tm:   NewHeatmap(2024),
+			msg:  tea.KeyMsg{Type: tea.KeyUp},
+			want: newDate(2024, time.December, 31),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.December, 31)},
+			},
+		},
+		{
+			name:   "down-skips-hidden-weekend",
+			tm:     NewHeatmap(2024).Weekdays(weekdays),
+			active: newDate(2024, time.September, 6),
+			msg:    tea.KeyMsg{Type: tea.KeyDown},
+			want:   newDate(2024, time.September, 9),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 9)},
+			},
+		},
+		{
+			name:   "right-next-week",
+			tm:     NewHeatmap(2024),
+			active: newDate(2024, time.September, 6),
+			msg:    tea.KeyMsg{Type: tea.KeyRight},
+			want:   newDate(2024, time.September, 13),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 13)},
+			},
+		},
+		{
+			name:   "right-next-year",
+			tm:     NewHeatmap(2024),
+			active: newDate(2024, time.December, 30),
+			msg:    tea.KeyMsg{Type: tea.KeyRight},
+			want:   newDate(2025, time.January, 6),
+			wantMsgs: []tea.Msg{
+				HeatmapChangedMsg{Start: newDate(2025, time.January, 1), End: newDate(2025, time.December, 31)},
+				ActiveDateMsg{Date: newDate(2025, time.January, 6)},
+			},
+		},
+		{
+			name:   "left-previous-weeks",
+			tm:     NewHeatmapWeeks(newDate(2024, time.September, 18), 4),
+			active: newDate(2024, time.August, 27),
+			msg:    tea.KeyMsg{Type: tea.KeyLeft},
+			want:   newDate(2024, time.August, 20),
+			wantMsgs: []tea.Msg{
+				HeatmapChangedMsg{Start: newDate(2024, time.July, 28), End: newDate(2024, time.August, 21)},
+				ActiveDateMsg{Date: newDate(2024, time.August, 20)},
+			},
+		},
+		{
+			name:   "next-page",
+			tm:     NewHeatmapWeeks(newDate(2024, time.September, 18), 4),
+			active: newDate(2024, time.September, 17),
+			msg:    tea.KeyMsg{Type: tea.KeyPgDown},
+			want:   newDate(2024, time.October, 15),
+			wantMsgs: []tea.Msg{
+				HeatmapChangedMsg{Start: newDate(2024, time.September, 22), End: newDate(2024, time.October, 16)},
+				ActiveDateMsg{Date: newDate(2024, time.October, 15)},
+			},
+		},
+		{
+			name:   "next-page-hidden-weekday",
+			tm:     NewHeatmap(2024).Weekdays(weekdays),
+			active: newDate(2024, time.September, 6),
+			msg:    tea.KeyMsg{Type: tea.KeyPgDown},
+			want:   newDate(2025, time.September, 8),
+			wantMsgs: []tea.Msg{
+				HeatmapChangedMsg{Start: newDate(2025, time.January, 1), End: newDate(2025, time.December, 31)},
+				ActiveDateMsg{Date: newDate(2025, time.September, 8)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := tt.tm
+			tm.activeDate = tt.active
+
+			// Test
+			got, gotCmd := tm.Update(tt.msg)
+
+			// Assertions
+			assert.Equal(t, tt.want, got.(HeatmapModel).ActiveDate())
+			assert.Equal(t, tt.wantMsgs, collectMsgs(gotCmd))
+		})
+	}
+}
+
+func TestHeatmapModel_View(t *testing.T) {
+	weekdays := Weekdays{
+		time.Monday:    "Mon",
+		time.Tuesday:   "Tue",
+		time.Wednesday: "Wed",
+		time.Thursday:  "Thu",
+		time.Friday:    "Fri",
+	}
+	values := map[time.Time]float64{
+		newDate(2024, time.January, 3):   10,
+		newDate(2024, time.March, 14):    25,
+		newDate(2024, time.June, 1):      60,
+		newDate(2024, time.September, 2): 45,
+		newDate(2024, time.December, 31): 5,
+	}
+	tests := []struct {
+		name string
+		tm   HeatmapModel
+	}{
+		{
+			name: "year",
+			tm:   NewHeatmap(2024).Values(values),
+		},
+		{
+			name: "weeks-thresholds",
+			tm: NewHeatmapWeeks(newDate(2024, time.September, 18), 12).
+				StartOfWeek(time.Monday).
+				Weekdays(weekdays).
+				Scale(HeatmapScale{Colors: DefaultHeatmapScale().Colors, Thresholds: []float64{1, 20, 40, 60}}).
+				Values(values),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := tt.tm
+			tm.activeDate = newDate(2024, time.September, 2)
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Holiday is a public holiday on a calendar date.
+type Holiday struct {
+	// Date of the holiday, of which only the calendar date is used
+	Date time.Time
+
+	// Name to display
+	Name string
+
+	// Observed marks holidays moved from a weekend to a nearby weekday
+	Observed bool
+}
+
+// HolidayProvider provides the holidays shown on a calendar. Calendars query the provider for the years of the dates
+// they show.
+type HolidayProvider interface {
+	// Holidays returns the holidays whose dates fall in a year, ordered by date.
+	Holidays(year int) []Holiday
+}
+
+// holidayOn finds the holiday on a date, comparing calendar dates in the date's location.
+func holidayOn(provider HolidayProvider, date time.Time) (Holiday, bool) {
+	if provider == nil {
+		return Holiday{}, false
+	}
+
+	date = normalizeDate(date)
+	for _, h := range provider.Holidays(date.Year()) {
+		if sameDateIn(h.Date, date.Location()).Equal(date) {
+			return h, true
+		}
+	}
+	return Holiday{}, false
+}
+
+// ObservedRule shifts a holiday that falls on a weekend to a weekday.
+type ObservedRule int
+
+const (
+	// ObservedNone keeps holidays on weekends
+	ObservedNone ObservedRule = iota
+	// ObservedNearestWeekday moves Saturday holidays to Friday and Sunday holidays to Monday, as in the US
+	ObservedNearestWeekday
+	// ObservedNextMonday moves Saturday and Sunday holidays to the following Monday
+	ObservedNextMonday
+)
+
+// shift moves a date that falls on a weekend.
+func (o ObservedRule) shift(date time.Time) time.Time {
+	switch {
+	case (o == ObservedNearestWeekday) && (date.Weekday() == time.Saturday):
+		return date.AddDate(0, 0, -1)
+	case (o == ObservedNearestWeekday) && (date.Weekday() == time.Sunday):
+		return date.AddDate(0, 0, 1)
+	case (o == ObservedNextMonday) && (date.Weekday() == time.Saturday):
+		return date.AddDate(0, 0, 2)
+	case (o == ObservedNextMonday) && (date.Weekday() == time.Sunday):
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// HolidayRule calculates the date of a holiday in each year. The date is either a fixed day of a month, the Nth
+// weekday of a month, or a number of days from Easter Sunday.
+type HolidayRule struct {
+	// Name of the holiday
+	Name string
+
+	// Month of the holiday, for fixed and weekday rules
+	Month time.Month
+
+	// Day of the month, for fixed rules
+	Day int
+
+	// Weekday and its occurrence in the month, for weekday rules, e.g. 3 for the third Monday or -1 for the last Monday
+	Weekday time.Weekday
+	N       int
+
+	// Easter marks rules relative to Easter Sunday, by EasterOffset days, e.g. -2 for Good Friday
+	Easter       bool
+	EasterOffset int
+
+	// Observed shifts the holiday when it falls on a weekend
+	Observed ObservedRule
+
+	// Since is the first year in which the holiday is held. Zero means all years.
+	Since int
+}
+
+// Date calculates the date of the holiday in a year, in UTC, before it is shifted by the observed rule. If the holiday
+// is not held in the year, ok is false.
+func (r HolidayRule) Date(year int) (date time.Time, ok bool) {
+	if year < r.Since {
+		return time.Time{}, false
+	}
+
+	switch {
+	case r.Easter:
+		return easterSunday(year).AddDate(0, 0, r.EasterOffset), true
+	case r.N > 0:
+		first := time.Date(year, r.Month, 1, 0, 0, 0, 0, time.UTC)
+		date = first.AddDate(0, 0, (int(r.Weekday)-int(first.Weekday())+7)%7+7*(r.N-1))
+	case r.N < 0:
+		last := time.Date(year, r.Month+1, 0, 0, 0, 0, 0, time.UTC)
+		date = last.AddDate(0, 0, -(int(last.Weekday())-int(r.Weekday)+7)%7+7*(r.N+1))
+	default:
+		date = time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC)
+	}
+
+	// Weekdays past the end of the month and days that overflow the month do not exist
+	if date.Month() != r.Month {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// easterSunday calculates the date of Easter Sunday in the Gregorian calendar, by the anonymous Gregorian algorithm.
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// HolidayRules is a HolidayProvider that calculates holidays from rules.
+type HolidayRules []HolidayRule
+
+// Holidays returns the holidays whose dates, after being shifted by their observed rules, fall in a year.
+func (rules HolidayRules) Holidays(year int) []Holiday {
+	var holidays []Holiday
+	for _, r := range rules {
+		// Observed dates may move into the neighboring years
+		for y := year - 1; y <= year+1; y++ {
+			date, ok := r.Date(y)
+			if !ok {
+				continue
+			}
+			observed := r.Observed.shift(date)
+			if observed.Year() == year {
+				holidays = append(holidays, Holiday{Date: observed, Name: r.Name, Observed: !observed.Equal(date)})
+			}
+		}
+	}
+	slices.SortStableFunc(holidays, func(a Holiday, b Holiday) int { return a.Date.Compare(b.Date) })
+
+	return holidays
+}
+
+// USFederalHolidays provides the federal holidays of the United States, as observed by federal employees.
+func USFederalHolidays() HolidayRules {
+	return HolidayRules{
+		{Name: "New Year's Day", Month: time.January, Day: 1, Observed: ObservedNearestWeekday},
+		{Name: "Martin Luther King Jr. Day", Month: time.January, Weekday: time.Monday, N: 3, Since: 1986},
+		{Name: "Washington's Birthday", Month: time.February, Weekday: time.Monday, N: 3},
+		{Name: "Memorial Day", Month: time.May, Weekday: time.Monday, N: -1},
+		{Name: "Juneteenth", Month: time.June, Day: 19, Observed: ObservedNearestWeekday, Since: 2021},
+		{Name: "Independence Day", Month: time.July, Day: 4, Observed: ObservedNearestWeekday},
+		{Name: "Labor Day", Month: time.September, Weekday: time.Monday, N: 1},
+		{Name: "Columbus Day", Month: time.October, Weekday: time.Monday, N: 2},
+		{Name: "Veterans Day", Month: time.November, Day: 11, Observed: ObservedNearestWeekday},
+		{Name: "Thanksgiving Day", Month: time.November, Weekday: time.Thursday, N: 4},
+		{Name: "Christmas Day", Month: time.December, Day: 25, Observed: ObservedNearestWeekday},
+	}
+}
+
+// ParseHolidayRules reads holiday rules from a data file, so that calendars other than the built-in ones may be used.
+//
+// Each line holds a rule and the name of the holiday, separated by a colon. Blank lines and lines beginning with "#"
+// are ignored. A rule is one of:
+//
+//	12-25              a fixed month and day
+//	3 Mon Jan          the third Monday of January; "last" or a negative number counts from the end of the month
+//	easter-2           a number of days from Easter Sunday
+//
+// and may be followed by "observed" or "observed-monday" to shift weekend holidays as ObservedNearestWeekday or
+// ObservedNextMonday do, and by "since" and a year, e.g.:
+//
+//	06-19 observed since 2021: Juneteenth
+func ParseHolidayRules(r io.Reader) (HolidayRules, error) {
+	var rules HolidayRules
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if (line == "") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		spec, name, ok := strings.Cut(line, ":")
+		if !ok || (strings.TrimSpace(name) == "") {
+			return nil, fmt.Errorf("holidays line %d: missing name in %q", n, line)
+		}
+		rule, err := parseHolidayRule(strings.Fields(spec))
+		if err != nil {
+			return nil, fmt.Errorf("holidays line %d: %w", n, err)
+		}
+		rule.Name = strings.TrimSpace(name)
+		rules = append(rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("holidays: %w", err)
+	}
+
+	return rules, nil
+}
+
+// parseHolidayRule parses the fields of a rule, without its name.
+func parseHolidayRule(fields []string) (HolidayRule, error) {
+	var r HolidayRule
+	if len(fields) == 0 {
+		return r, fmt.Errorf("missing rule")
+	}
+
+	// Date
+	var err error
+	switch first := strings.ToLower(fields[0]); {
+	case strings.HasPrefix(first, "easter"):
+		r.Easter = true
+		if offset := strings.TrimPrefix(first, "easter"); offset != "" {
+			if r.EasterOffset, err = strconv.Atoi(offset); err != nil {
+				return r, fmt.Errorf("invalid Easter offset %q", fields[0])
+			}
+		}
+		fields = fields[1:]
+	case strings.Index(first, "-") > 0:
+		var month int
+		if _, err = fmt.Sscanf(first, "%d-%d", &month, &r.Day); (err != nil) || (month < 1) || (month > 12) {
+			return r, fmt.Errorf("invalid date %q", fields[0])
+		}
+		r.Month = time.Month(month)
+		fields = fields[1:]
+	default:
+		if len(fields) < 3 {
+			return r, fmt.Errorf("invalid rule %q", strings.Join(fields, " "))
+		}
+		if first == "last" {
+			r.N = -1
+		} else if r.N, err = strconv.Atoi(first); (err != nil) || (r.N == 0) {
+			return r, fmt.Errorf("invalid occurrence %q", fields[0])
+		}
+		if r.Weekday, err = parseWeekdayName(fields[1]); err != nil {
+			return r, err
+		}
+		if r.Month, err = parseMonthName(fields[2]); err != nil {
+			return r, err
+		}
+		fields = fields[3:]
+	}
+
+	// Options
+	for len(fields) > 0 {
+		switch strings.ToLower(fields[0]) {
+		case "observed":
+			r.Observed = ObservedNearestWeekday
+		case "observed-monday":
+			r.Observed = ObservedNextMonday
+		case "since":
+			if len(fields) < 2 {
+				return r, fmt.Errorf("missing year after since")
+			}
+			if r.Since, err = strconv.Atoi(fields[1]); err != nil {
+				return r, fmt.Errorf("invalid year %q", fields[1])
+			}
+			fields = fields[1:]
+		default:
+			return r, fmt.Errorf("unknown option %q", fields[0])
+		}
+		fields = fields[1:]
+	}
+
+	return r, nil
+}
+
+// parseWeekdayName parses an English weekday name or its first three letters.
+func parseWeekdayName(name string) (time.Weekday, error) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if strings.EqualFold(name, wd.String()) || strings.EqualFold(name, wd.String()[:3]) {
+			return wd, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", name)
+}
+
+// parseMonthName parses an English month name or its first three letters.
+func parseMonthName(name string) (time.Month, error) {
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(name, m.String()) || strings.EqualFold(name, m.String()[:3]) {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid month %q", name)
+}
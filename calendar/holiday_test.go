@@ -0,0 +1,332 @@
+package calendar
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_easterSunday(t *testing.T) {
+	tests := []struct {
+		year int
+		want time.Time
+	}{
+		{year: 2019, want: newDate(2019, time.April, 21)},
+		{year: 2024, want: newDate(2024, time.March, 31)},
+		{year: 2025, want: newDate(2025, time.April, 20)},
+		{year: 2038, want: newDate(2038, time.April, 25)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want.Format(time.DateOnly), func(t *testing.T) {
+			// Test
+			got := easterSunday(tt.year)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHolidayRule_Date(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   HolidayRule
+		year   int
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name:   "fixed",
+			rule:   HolidayRule{Month: time.July, Day: 4},
+			year:   2024,
+			want:   newDate(2024, time.July, 4),
+			wantOk: true,
+		},
+		{
+			name: "fixed-missing",
+			rule: HolidayRule{Month: time.February, Day: 30},
+			year: 2024,
+		},
+		{
+			name:   "nth-weekday",
+			rule:   HolidayRule{Month: time.January, Weekday: time.Monday, N: 3},
+			year:   2024,
+			want:   newDate(2024, time.January, 15),
+			wantOk: true,
+		},
+		{
+			name:   "last-weekday",
+			rule:   HolidayRule{Month: time.May, Weekday: time.Monday, N: -1},
+			year:   2024,
+			want:   newDate(2024, time.May, 27),
+			wantOk: true,
+		},
+		{
+			name:   "second-to-last-weekday",
+			rule:   HolidayRule{Month: time.August, Weekday: time.Saturday, N: -2},
+			year:   2024,
+			want:   newDate(2024, time.August, 24),
+			wantOk: true,
+		},
+		{
+			name: "fifth-weekday-missing",
+			rule: HolidayRule{Month: time.February, Weekday: time.Monday, N: 5},
+			year: 2024,
+		},
+		{
+			name:   "easter",
+			rule:   HolidayRule{Easter: true, EasterOffset: -2},
+			year:   2024,
+			want:   newDate(2024, time.March, 29),
+			wantOk: true,
+		},
+		{
+			name: "before-since",
+			rule: HolidayRule{Month: time.June, Day: 19, Since: 2021},
+			year: 2020,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got, gotOk := tt.rule.Date(tt.year)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
+
+func TestUSFederalHolidays(t *testing.T) {
+	// Test
+	got := USFederalHolidays().Holidays(2021)
+
+	// Assertions
+	assert.Equal(t, []Holiday{
+		{Date: newDate(2021, time.January, 1), Name: "New Year's Day"},
+		{Date: newDate(2021, time.January, 18), Name: "Martin Luther King Jr. Day"},
+		{Date: newDate(2021, time.February, 15), Name: "Washington's Birthday"},
+		{Date: newDate(2021, time.May, 31), Name: "Memorial Day"},
+		{Date: newDate(2021, time.June, 18), Name: "Juneteenth", Observed: true},
+		{Date: newDate(2021, time.July, 5), Name: "Independence Day", Observed: true},
+		{Date: newDate(2021, time.September, 6), Name: "Labor Day"},
+		{Date: newDate(2021, time.October, 11), Name: "Columbus Day"},
+		{Date: newDate(2021, time.November, 11), Name: "Veterans Day"},
+		{Date: newDate(2021, time.November, 25), Name: "Thanksgiving Day"},
+		{Date: newDate(2021, time.December, 24), Name: "Christmas Day", Observed: true},
+		// New Year's Day of 2022 falls on a Saturday, so it is observed in 2021
+		{Date: newDate(2021, time.December, 31), Name: "New Year's Day", Observed: true},
+	}, got)
+}
+
+func TestParseHolidayRules(t *testing.T) {
+	// Setup
+	f, err := os.Open(filepath.Join("testdata", "holidays", "de.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	// Test
+	rules, err := ParseHolidayRules(f)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Len(t, rules, 9)
+	assert.Equal(t, HolidayRule{Name: "Tag der Deutschen Einheit", Month: time.October, Day: 3, Since: 1990}, rules[6])
+
+	var got []string
+	for _, h := range rules.Holidays(2024) {
+		got = append(got, h.Date.Format(time.DateOnly)+" "+h.Name)
+	}
+	assert.Equal(t, []string{
+		"2024-01-01 Neujahr",
+		"2024-03-29 Karfreitag",
+		"2024-04-01 Ostermontag",
+		"2024-05-01 Tag der Arbeit",
+		"2024-05-09 Christi Himmelfahrt",
+		"2024-05-20 Pfingstmontag",
+		"2024-10-03 Tag der Deutschen Einheit",
+		"2024-12-25 1. Weihnachtstag",
+		"2024-12-26 2. Weihnachtstag",
+	}, got)
+}
+
+func TestParseHolidayRules_Rules(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    HolidayRule
+		wantErr string
+	}{
+		{
+			line: "last Mon May: Memorial Day",
+			want: HolidayRule{Name: "Memorial Day", Month: time.May, Weekday: time.Monday, N: -1},
+		},
+		{
+			line: "-2 saturday august: Late Summer",
+			want: HolidayRule{Name: "Late Summer", Month: time.August, Weekday: time.Saturday, N: -2},
+		},
+		{
+			line: "12-25 observed-monday: Christmas Day",
+			want: HolidayRule{Name: "Christmas Day", Month: time.December, Day: 25, Observed: ObservedNextMonday},
+		},
+		{
+			line: "easter: Easter Sunday",
+			want: HolidayRule{Name: "Easter Sunday", Easter: true},
+		},
+		{
+			line:    "12-25",
+			wantErr: `holidays line 1: missing name in "12-25"`,
+		},
+		{
+			line:    "13-01: Nothing",
+			wantErr: `holidays line 1: invalid date "13-01"`,
+		},
+		{
+			line:    "3 Mon Smarch: Nothing",
+			wantErr: `holidays line 1: invalid month "Smarch"`,
+		},
+		{
+			line:    "0 Mon May: Nothing",
+			wantErr: `holidays line 1: invalid occurrence "0"`,
+		},
+		{
+			line:    "easter+x: Nothing",
+			wantErr: `holidays line 1: invalid Easter offset "easter+x"`,
+		},
+		{
+			line:    "01-01 since: Nothing",
+			wantErr: "holidays line 1: missing year after since",
+		},
+		{
+			line:    "01-01 weekly: Nothing",
+			wantErr: `holidays line 1: unknown option "weekly"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			// Test
+			got, err := ParseHolidayRules(strings.NewReader(tt.line))
+
+			// Assertions
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, HolidayRules{tt.want}, got)
+		})
+	}
+}
+
+func TestMonthModel_Holidays(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).Holidays(USFederalHolidays())
+
+	// Test
+	got, gotOk := tm.Holiday(newDate(2024, time.September, 2))
+	_, gotNone := tm.Holiday(newDate(2024, time.September, 3))
+
+	// Assertions
+	assert.True(t, gotOk)
+	assert.Equal(t, "Labor Day", got.Name)
+	assert.False(t, gotNone)
+	assert.True(t, tm.DateState(newDate(2024, time.September, 2)).Holiday)
+	assert.False(t, tm.IsDisabled(newDate(2024, time.September, 2)))
+	assert.True(t, tm.SkipHolidays(true).IsDisabled(newDate(2024, time.September, 2)))
+}
+
+func TestMonthModel_Update_SkipHolidays(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).
+		SkipHolidays(true).
+		Holidays(USFederalHolidays())
+	tm.activeDay = 1
+
+	// Test
+	got, gotCmd := tm.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	// Assertions
+	assert.Equal(t, 3, got.(MonthModel).activeDay)
+	assert.Equal(t, []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 3)}}, collectMsgs(gotCmd))
+}
+
+func TestWeekModel_Update_SkipHolidays(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.November, 24)).
+		Holidays(USFederalHolidays()).
+		SkipHolidays(true)
+	tm.activeDate = newDate(2024, time.November, 27)
+
+	// Test
+	got, _ := tm.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	// Assertions
+	assert.Equal(t, newDate(2024, time.November, 29), got.(WeekModel).activeDate)
+}
+
+func TestMonthModel_View_Holidays(t *testing.T) {
+	// Setup
+	styles := DefaultMonthStyles()
+	styles.DateStyles.Width = 12
+	styles.DateStyles.Height = 3
+	styles.DateStyles.BodyStyle = styles.DateStyles.BodyStyle.Width(12).Height(2)
+	tm := NewMonth(2024, time.November).
+		Styles(styles).
+		Holidays(USFederalHolidays()).
+		HolidayLabels(true).
+		Events(EventList{
+			{Title: "Parade", Start: newTime(2024, time.November, 11, 11, 0)},
+		})
+
+	// Test
+	got := ansi.Strip(tm.ViewWeeks())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
+
+func TestMonthModel_View_HolidayEventBar(t *testing.T) {
+	// Setup
+	styles := DefaultMonthStyles()
+	styles.DateStyles.Width = 12
+	styles.DateStyles.Height = 4
+	styles.DateStyles.BodyStyle = styles.DateStyles.BodyStyle.Width(12).Height(3)
+	tm := NewMonth(2024, time.November).
+		Styles(styles).
+		Holidays(USFederalHolidays()).
+		HolidayLabels(true).
+		Events(EventList{
+			{Title: "Conference in Denver", Start: newDate(2024, time.November, 10), End: newDate(2024, time.November, 14), AllDay: true},
+			{Title: "Parade", Start: newTime(2024, time.November, 11, 11, 0)},
+		})
+
+	// Test
+	got := ansi.Strip(tm.ViewWeeks())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
+
+func TestWeekModel_View_Holidays(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.November, 24)).
+		Holidays(USFederalHolidays()).
+		HolidayLabels(true)
+
+	// Test
+	got := ansi.Strip(tm.ViewDates())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
@@ -0,0 +1,683 @@
+package calendar
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ICS layouts for date and date-time values.
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405"
+)
+
+// icsLine is an unfolded content line of an iCalendar stream, e.g. "DTSTART;TZID=Europe/Berlin:20240910T090000".
+type icsLine struct {
+	// number of the physical line on which the content line starts, for error messages
+	number int
+
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICS reads the events of an iCalendar (RFC 5545) stream, such as an .ics file, so that they may be shown by a
+// MonthModel or WeekModel as an EventSource.
+//
+// Each VEVENT becomes an event with its UID, SUMMARY, LOCATION, first CATEGORIES value, DTSTART and DTEND or
+// DURATION, and a recurrence from its RRULE and EXDATE. Events with an RRULE that ParseRecurrence does not support are
+// kept as a single occurrence. Dates without a time become all-day events. Times ending in "Z" are UTC, and times
+// without a TZID are interpreted in the local time zone. A TZID is interpreted as an IANA time zone, or as a Windows
+// time zone name such as Outlook writes, e.g. "Eastern Standard Time". Other TZIDs are resolved from the stream's
+// VTIMEZONE if it has a single offset, and are otherwise interpreted as UTC. Other components, such as VALARM, and
+// unknown properties are ignored.
+func ParseICS(r io.Reader) (EventList, error) {
+	lines, err := readICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+	zones := readICSTimeZones(lines)
+
+	var events EventList
+	var event *Event
+	var hasEnd bool
+	var duration time.Duration
+	// exceptions are buffered until the end of the event, as EXDATE may precede RRULE
+	var exceptions []time.Time
+	// nested counts the components open inside the current event, whose properties are ignored
+	nested := 0
+	for _, line := range lines {
+		switch {
+		case (line.name == "BEGIN") && (event == nil):
+			if strings.EqualFold(line.value, "VEVENT") {
+				event = &Event{}
+				hasEnd = false
+				duration = 0
+				exceptions = nil
+			}
+			continue
+		case line.name == "BEGIN":
+			nested++
+			continue
+		case (line.name == "END") && (event != nil) && (nested > 0):
+			nested--
+			continue
+		case (line.name == "END") && (event != nil):
+			if !strings.EqualFold(line.value, "VEVENT") {
+				return nil, fmt.Errorf("ics line %d: unexpected END:%s in VEVENT", line.number, line.value)
+			}
+			if event.Start == (time.Time{}) {
+				return nil, fmt.Errorf("ics line %d: event has no DTSTART", line.number)
+			}
+			if !hasEnd && (duration != 0) {
+				event.End = event.Start.Add(duration)
+				if event.AllDay {
+					event.End = event.Start.AddDate(0, 0, int(duration/(24*time.Hour)))
+				}
+			}
+			if event.Recurrence != nil {
+				event.Recurrence.Exceptions = exceptions
+			}
+			events = append(events, *event)
+			event = nil
+			continue
+		case (event == nil) || (nested > 0):
+			continue
+		}
+
+		switch line.name {
+		case "UID":
+			event.UID = line.value
+		case "SUMMARY":
+			event.Title = unescapeICSText(line.value)
+		case "LOCATION":
+			event.Location = unescapeICSText(line.value)
+		case "CATEGORIES":
+			category, _, _ := strings.Cut(line.value, ",")
+			event.Category = unescapeICSText(category)
+		case "DTSTART":
+			event.Start, event.AllDay, err = parseICSTime(line, zones)
+		case "DTEND":
+			event.End, _, err = parseICSTime(line, zones)
+			hasEnd = true
+		case "DURATION":
+			duration, err = parseICSDuration(line.value)
+		case "RRULE":
+			var r Recurrence
+			r, err = ParseRecurrence(line.value)
+			switch {
+			case err == nil:
+				event.Recurrence = &r
+			case errors.Is(err, ErrUnsupportedRecurrence):
+				// Rules that cannot be expanded leave the event as a single occurrence
+				err = nil
+			}
+		case "EXDATE":
+			// EXDATE may list several values, which share the parameters of the line
+			for _, value := range strings.Split(line.value, ",") {
+				var t time.Time
+				if t, _, err = parseICSTime(icsLine{params: line.params, value: value}, zones); err != nil {
+					break
+				}
+				exceptions = append(exceptions, t)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ics line %d: %s: %w", line.number, line.name, err)
+		}
+	}
+	if event != nil {
+		return nil, fmt.Errorf("ics: unterminated VEVENT")
+	}
+
+	return events, nil
+}
+
+// readICSLines reads and unfolds the content lines of an iCalendar stream. Lines beginning with a space or tab
+// continue the previous line.
+func readICSLines(r io.Reader) ([]icsLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	type rawLine struct {
+		number int
+		text   string
+	}
+	var raw []rawLine
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if (len(raw) > 0) && (strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")) {
+			raw[len(raw)-1].text += text[1:]
+			continue
+		}
+		if text == "" {
+			continue
+		}
+		raw = append(raw, rawLine{number: n, text: text})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ics: %w", err)
+	}
+
+	lines := make([]icsLine, 0, len(raw))
+	for _, l := range raw {
+		line, err := parseICSLine(l.text)
+		if err != nil {
+			return nil, fmt.Errorf("ics line %d: %w", l.number, err)
+		}
+		line.number = l.number
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// parseICSLine splits a content line into its name, parameters and value. Parameter values may be quoted, in which
+// case they may contain the ";" and ":" delimiters.
+func parseICSLine(text string) (icsLine, error) {
+	var fields []string
+	quoted := false
+	start := 0
+	for i, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case (r == ';') && !quoted:
+			fields = append(fields, text[start:i])
+			start = i + 1
+		case (r == ':') && !quoted:
+			fields = append(fields, text[start:i])
+			line := icsLine{
+				name:   strings.ToUpper(fields[0]),
+				params: make(map[string]string),
+				value:  text[i+1:],
+			}
+			for _, param := range fields[1:] {
+				key, value, _ := strings.Cut(param, "=")
+				line.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+			}
+			return line, nil
+		}
+	}
+
+	return icsLine{}, fmt.Errorf("missing ':' in %q", text)
+}
+
+// parseICSTime parses a DATE or DATE-TIME value, reporting whether the value is a date without a time.
+func parseICSTime(line icsLine, zones icsZones) (time.Time, bool, error) {
+	value := line.value
+	if strings.EqualFold(line.params["VALUE"], "DATE") || (len(value) == len(icsDateLayout)) {
+		t, err := time.Parse(icsDateLayout, value)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icsDateTimeLayout+"Z", value)
+		return t, false, err
+	}
+
+	loc := time.Local
+	if tzid := line.params["TZID"]; tzid != "" {
+		loc = zones.location(tzid)
+	}
+	t, err := time.ParseInLocation(icsDateTimeLayout, value, loc)
+	return t, false, err
+}
+
+// icsZones holds the locations of the TZIDs defined by the VTIMEZONE components of a stream.
+type icsZones map[string]*time.Location
+
+// readICSTimeZones resolves the TZID of each VTIMEZONE, given the offsets of its STANDARD and DAYLIGHT components.
+func readICSTimeZones(lines []icsLine) icsZones {
+	zones := make(icsZones)
+	inZone := false
+	var tzid string
+	var offsets []string
+	for _, line := range lines {
+		switch {
+		case (line.name == "BEGIN") && strings.EqualFold(line.value, "VTIMEZONE"):
+			inZone = true
+			tzid = ""
+			offsets = nil
+		case !inZone:
+			continue
+		case (line.name == "END") && strings.EqualFold(line.value, "VTIMEZONE"):
+			inZone = false
+			if tzid != "" {
+				zones[tzid] = resolveTZID(tzid, offsets)
+			}
+		case line.name == "TZID":
+			tzid = line.value
+		case line.name == "TZOFFSETTO":
+			offsets = append(offsets, line.value)
+		}
+	}
+
+	return zones
+}
+
+// location finds the location of a TZID, whether or not the stream defines it.
+func (z icsZones) location(tzid string) *time.Location {
+	if loc, ok := z[tzid]; ok {
+		return loc
+	}
+	return resolveTZID(tzid, nil)
+}
+
+// resolveTZID finds the location of a TZID by its IANA or Windows time zone name. Time zones known by neither name are
+// fixed at their offset if they have a single offset, since they have no daylight saving time, or else are UTC.
+func resolveTZID(tzid string, offsets []string) *time.Location {
+	name := strings.TrimPrefix(tzid, "/")
+	if loc, err := time.LoadLocation(name); err == nil {
+		return loc
+	}
+	if alias, ok := windowsTimeZones[name]; ok {
+		if loc, err := time.LoadLocation(alias); err == nil {
+			return loc
+		}
+	}
+
+	if len(offsets) > 0 {
+		offset, err := parseICSOffset(offsets[0])
+		fixed := err == nil
+		for _, o := range offsets[1:] {
+			if other, err := parseICSOffset(o); (err != nil) || (other != offset) {
+				fixed = false
+			}
+		}
+		if fixed {
+			return time.FixedZone(name, offset)
+		}
+	}
+	return time.UTC
+}
+
+// parseICSOffset parses a UTC offset, e.g. "+0100", "-0430" or "+053000", into seconds.
+func parseICSOffset(value string) (int, error) {
+	if (len(value) != 5) && (len(value) != 7) || ((value[0] != '+') && (value[0] != '-')) {
+		return 0, fmt.Errorf("invalid offset %q", value)
+	}
+
+	var offset int
+	for i, unit := range []int{3600, 60, 1} {
+		if 1+(2*i) >= len(value) {
+			break
+		}
+		n, err := strconv.Atoi(value[1+(2*i) : 3+(2*i)])
+		if err != nil {
+			return 0, fmt.Errorf("invalid offset %q", value)
+		}
+		offset += n * unit
+	}
+	if value[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, nil
+}
+
+// windowsTimeZones maps the Windows time zone names that Outlook and Exchange write as TZIDs to IANA time zones.
+var windowsTimeZones = map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time":          "America/Denver",
+	"Central Standard Time":           "America/Chicago",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time":           "America/New_York",
+	"US Eastern Standard Time":        "America/Indiana/Indianapolis",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"Argentina Standard Time":         "America/Argentina/Buenos_Aires",
+	"UTC":                             "UTC",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"FLE Standard Time":               "Europe/Helsinki",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Russian Standard Time":           "Europe/Moscow",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"India Standard Time":             "Asia/Kolkata",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"China Standard Time":             "Asia/Shanghai",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"Korea Standard Time":             "Asia/Seoul",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+}
+
+// icsDurationPattern matches a DURATION value, e.g. "PT1H30M", "P1D" or "-P2W".
+var icsDurationPattern = regexp.MustCompile(`^([+-]?)P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseICSDuration parses a DURATION value.
+func parseICSDuration(value string) (time.Duration, error) {
+	match := icsDurationPattern.FindStringSubmatch(value)
+	if (match == nil) || (value == "P") || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		d += time.Duration(n) * unit
+	}
+	if match[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// unescapeICSText replaces the escape sequences of a TEXT value.
+func unescapeICSText(value string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(value)
+}
+
+// icsProdID identifies this package as the producer of written iCalendar streams.
+const icsProdID = "-//shawalli//bubbles calendar//EN"
+
+// WriteICS writes events as an iCalendar (RFC 5545) stream, which may be saved as an .ics file and imported into other
+// calendar clients.
+//
+// All-day events are written as dates. Timed events in UTC or the local time zone are written in UTC, and timed events
+// in other time zones are written with a TZID, for which a VTIMEZONE describing the time zone's offsets during the
+// years of the events, and its yearly daylight saving rules after them, is included. Recurring events are written with
+// an RRULE and EXDATE. Events without a UID are given one derived from their title and start. Every event is stamped
+// with the time at which it is written, which is usually time.Now().
+func WriteICS(w io.Writer, events []Event, stamp time.Time) error {
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:"+icsProdID)
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+
+	for _, tz := range icsTimeZones(events) {
+		tz.write(&b)
+	}
+
+	dtstamp := stamp.UTC().Format(icsDateTimeLayout + "Z")
+	for _, e := range events {
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, "UID:"+cmp.Or(e.UID, icsUID(e)))
+		writeICSLine(&b, "DTSTAMP:"+dtstamp)
+		if e.AllDay {
+			start, end := e.span(time.UTC)
+			writeICSLine(&b, "DTSTART;VALUE=DATE:"+start.Format(icsDateLayout))
+			writeICSLine(&b, "DTEND;VALUE=DATE:"+end.Format(icsDateLayout))
+		} else {
+			writeICSLine(&b, "DTSTART"+formatICSTime(e.Start))
+			if e.End.After(e.Start) {
+				writeICSLine(&b, "DTEND"+formatICSTime(e.End))
+			}
+		}
+		writeICSLine(&b, "SUMMARY:"+escapeICSText(e.Title))
+		if e.Location != "" {
+			writeICSLine(&b, "LOCATION:"+escapeICSText(e.Location))
+		}
+		if e.Category != "" {
+			writeICSLine(&b, "CATEGORIES:"+escapeICSText(e.Category))
+		}
+		if e.Recurrence != nil {
+			writeICSLine(&b, "RRULE:"+e.Recurrence.String())
+			for _, t := range e.Recurrence.Exceptions {
+				if e.AllDay {
+					writeICSLine(&b, "EXDATE;VALUE=DATE:"+sameDateIn(t, time.UTC).Format(icsDateLayout))
+				} else {
+					writeICSLine(&b, "EXDATE"+formatICSTime(t))
+				}
+			}
+		}
+		writeICSLine(&b, "END:VEVENT")
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeICSLine writes a content line, folding it so that no line is longer than 75 octets, as RFC 5545 requires.
+// Lines are only folded between characters, so that multi-byte characters are kept intact.
+func writeICSLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		i := limit
+		for !utf8.RuneStart(line[i]) {
+			i--
+		}
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+		// Continuation lines begin with a space, which counts towards their length
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// formatICSTime formats the parameters and value of a DATE-TIME property, starting with the delimiter after the
+// property name.
+func formatICSTime(t time.Time) string {
+	if isUTCOrLocal(t.Location()) {
+		return ":" + t.UTC().Format(icsDateTimeLayout+"Z")
+	}
+	return ";TZID=" + t.Location().String() + ":" + t.Format(icsDateTimeLayout)
+}
+
+// isUTCOrLocal determines if times in a location are written in UTC, as the local time zone has no portable name.
+func isUTCOrLocal(loc *time.Location) bool {
+	return (loc == time.UTC) || (loc == time.Local) || (loc.String() == "UTC")
+}
+
+// icsUID derives a stable UID for an event from its title and start.
+func icsUID(e Event) string {
+	h := fnv.New64a()
+	_, _ = io.WriteString(h, e.Title)
+	_, _ = io.WriteString(h, e.Start.UTC().Format(time.RFC3339Nano))
+	return fmt.Sprintf("%016x@bubbles-calendar", h.Sum64())
+}
+
+// escapeICSText escapes a TEXT value.
+func escapeICSText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(value)
+}
+
+// icsTimeZone describes the offsets of a time zone from the start of a year until the start of a later year.
+type icsTimeZone struct {
+	loc *time.Location
+
+	// start and end of the described period
+	start time.Time
+	end   time.Time
+}
+
+// icsTimeZones collects the time zones of the timed events that are written with a TZID, each described for the years
+// of its events.
+func icsTimeZones(events []Event) []icsTimeZone {
+	var zones []icsTimeZone
+	for _, e := range events {
+		if e.AllDay {
+			continue
+		}
+		for _, t := range []time.Time{e.Start, e.End} {
+			if (t == (time.Time{})) || isUTCOrLocal(t.Location()) {
+				continue
+			}
+
+			start := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+			end := time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, t.Location())
+			i := slices.IndexFunc(zones, func(z icsTimeZone) bool { return z.loc.String() == t.Location().String() })
+			if i < 0 {
+				zones = append(zones, icsTimeZone{loc: t.Location(), start: start, end: end})
+				continue
+			}
+			zones[i].start = earlierTime(zones[i].start, start)
+			zones[i].end = laterTime(zones[i].end, end)
+		}
+	}
+
+	return zones
+}
+
+// earlierTime returns the earlier of two times.
+func earlierTime(a time.Time, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// write writes the time zone as a VTIMEZONE, with a STANDARD or DAYLIGHT component for the offset in effect at the
+// start of the period and for each change of offset during the period. The changes of the last year of the period
+// repeat yearly if the time zone changes by the same rule in the following year, so that the offsets of later years,
+// e.g. of recurring events, are described too.
+func (z icsTimeZone) write(b *strings.Builder) {
+	writeICSLine(b, "BEGIN:VTIMEZONE")
+	writeICSLine(b, "TZID:"+z.loc.String())
+
+	_, offset := z.start.Zone()
+	z.writeObservance(b, z.start, offset, "")
+	lastYear := z.end.AddDate(-1, 0, 0)
+	for t := z.start; t.Before(z.end); {
+		next, ok := nextZoneChange(t, z.end)
+		if !ok {
+			break
+		}
+		rule := ""
+		if !next.Before(lastYear) {
+			rule = yearlyZoneChange(next, offset)
+		}
+		z.writeObservance(b, next, offset, rule)
+		_, offset = next.Zone()
+		t = next
+	}
+
+	writeICSLine(b, "END:VTIMEZONE")
+}
+
+// writeObservance writes a STANDARD or DAYLIGHT component for the offset that takes effect at a time, which repeats by
+// a recurrence rule unless the rule is empty.
+func (z icsTimeZone) writeObservance(b *strings.Builder, onset time.Time, offsetFrom int, rule string) {
+	name, offsetTo := onset.Zone()
+	kind := "STANDARD"
+	if onset.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	writeICSLine(b, "BEGIN:"+kind)
+	writeICSLine(b, "DTSTART:"+localOnset(onset, offsetFrom).Format(icsDateTimeLayout))
+	if rule != "" {
+		writeICSLine(b, "RRULE:"+rule)
+	}
+	writeICSLine(b, "TZOFFSETFROM:"+formatICSOffset(offsetFrom))
+	writeICSLine(b, "TZOFFSETTO:"+formatICSOffset(offsetTo))
+	writeICSLine(b, "TZNAME:"+name)
+	writeICSLine(b, "END:"+kind)
+}
+
+// localOnset converts the onset of an offset to the local time in the offset in effect before it, as a time in UTC.
+func localOnset(onset time.Time, offsetFrom int) time.Time {
+	return onset.UTC().Add(time.Duration(offsetFrom) * time.Second)
+}
+
+// yearlyZoneChange derives the recurrence rule of a change of offset from the Nth or last weekday of its month, e.g.
+// "FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3" for the last Sunday of March. The rule is empty unless the time zone changes to
+// the same offset by the same rule in the following year.
+func yearlyZoneChange(onset time.Time, offsetFrom int) string {
+	local := localOnset(onset, offsetFrom)
+	n := (local.Day()-1)/7 + 1
+	if local.Day()+7 > DaysInMonth(local.Year(), local.Month()) {
+		n = -1
+	}
+	r := Recurrence{
+		Frequency: FrequencyYearly,
+		ByDay:     []RecurrenceDay{{Weekday: local.Weekday(), N: n}},
+		ByMonth:   []time.Month{local.Month()},
+	}
+
+	_, candidates := r.candidates(local, 1)
+	if len(candidates) != 1 {
+		return ""
+	}
+	next := candidates[0].Add(-time.Duration(offsetFrom) * time.Second).In(onset.Location())
+	_, offsetTo := onset.Zone()
+	_, nextFrom := next.Add(-time.Second).Zone()
+	_, nextTo := next.Zone()
+	if (nextFrom != offsetFrom) || (nextTo != offsetTo) {
+		return ""
+	}
+
+	return r.String()
+}
+
+// nextZoneChange finds the first instant after a time, and before an end, at which the offset of its location
+// changes. Offsets are compared a day apart, and the change is then found to the second.
+func nextZoneChange(t time.Time, end time.Time) (time.Time, bool) {
+	_, offset := t.Zone()
+	for day := t; day.Before(end); day = day.Add(24 * time.Hour) {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset == offset {
+			continue
+		}
+
+		lo, hi := day, next
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if _, midOffset := mid.Zone(); midOffset == offset {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		return hi, hi.Before(end)
+	}
+
+	return time.Time{}, false
+}
+
+// formatICSOffset formats a UTC offset in seconds, e.g. "+0100" or "-0430".
+func formatICSOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, (offset%3600)/60)
+}
@@ -0,0 +1,378 @@
+package calendar
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mustParseICSFile parses an iCalendar fixture from testdata/ics.
+func mustParseICSFile(t *testing.T, name string) EventList {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", "ics", name))
+	require.NoError(t, err)
+	defer f.Close()
+
+	events, err := ParseICS(f)
+	require.NoError(t, err)
+	return events
+}
+
+func TestParseICS(t *testing.T) {
+	// Setup
+	berlin := mustLoadLocation("Europe/Berlin")
+
+	// Test
+	got := mustParseICSFile(t, "meetings.ics")
+
+	// Assertions
+	assert.Equal(t, EventList{
+		{
+			UID:      "standup-20240910@example.com",
+			Title:    "Standup",
+			Location: "Room 4, Building B",
+			Category: "MEETING",
+			Start:    time.Date(2024, time.September, 10, 9, 30, 0, 0, berlin),
+			End:      time.Date(2024, time.September, 10, 9, 45, 0, 0, berlin),
+		},
+		{
+			UID:   "review-20240912@example.com",
+			Title: "Quarterly review with the product team and the leadership group to discuss the roadmap",
+			Start: newTime(2024, time.September, 12, 14, 0),
+			End:   newTime(2024, time.September, 12, 15, 30),
+		},
+		{
+			UID:    "offsite-2024@example.com",
+			Title:  "Team offsite",
+			Start:  newDate(2024, time.September, 16),
+			End:    newDate(2024, time.September, 18),
+			AllDay: true,
+		},
+		{
+			UID:    "holiday-20241003@example.com",
+			Title:  "Day of German Unity",
+			Start:  newDate(2024, time.October, 3),
+			AllDay: true,
+		},
+	}, got)
+}
+
+func TestParseICS_Invalid(t *testing.T) {
+	tests := []struct {
+		file    string
+		wantErr string
+	}{
+		{
+			file:    "unterminated.ics",
+			wantErr: "ics line 5: unexpected END:VCALENDAR in VEVENT",
+		},
+		{
+			file:    "invalid-date.ics",
+			wantErr: `ics line 3: DTSTART: parsing time`,
+		},
+		{
+			file:    "missing-start.ics",
+			wantErr: "ics line 4: event has no DTSTART",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			// Setup
+			f, err := os.Open(filepath.Join("testdata", "ics", tt.file))
+			require.NoError(t, err)
+			defer f.Close()
+
+			// Test
+			got, err := ParseICS(f)
+
+			// Assertions
+			assert.Nil(t, got)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParseICS_TimeZones(t *testing.T) {
+	// Setup
+	newYork := mustLoadLocation("America/New_York")
+
+	// Test
+	got := mustParseICSFile(t, "outlook.ics")
+
+	// Assertions
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, time.Date(2024, time.September, 10, 9, 0, 0, 0, newYork), got[0].Start)
+		assert.Equal(t, newYork, got[0].Start.Location())
+		assert.True(t, time.Date(2024, time.September, 10, 10, 0, 0, 0, newYork).Equal(got[0].End))
+
+		_, offset := got[1].Start.Zone()
+		assert.Equal(t, (5*60*60)+(30*60), offset)
+		assert.True(t, time.Date(2024, time.September, 11, 13, 0, 0, 0, time.UTC).Equal(got[1].Start))
+	}
+}
+
+func TestParseICS_UnknownTimeZone(t *testing.T) {
+	// Test
+	got := mustParseICSFile(t, "unknown-tzid.ics")
+
+	// Assertions
+	assert.Equal(t, EventList{
+		{Title: "Unknown zone", Start: newTime(2024, time.September, 10, 9, 0)},
+	}, got)
+}
+
+func Test_resolveTZID(t *testing.T) {
+	tests := []struct {
+		name       string
+		tzid       string
+		offsets    []string
+		wantName   string
+		wantOffset int
+	}{
+		{name: "iana", tzid: "Europe/Berlin", wantName: "Europe/Berlin", wantOffset: 3600},
+		{name: "iana-prefixed", tzid: "/Europe/Berlin", wantName: "Europe/Berlin", wantOffset: 3600},
+		{name: "windows", tzid: "W. Europe Standard Time", wantName: "Europe/Berlin", wantOffset: 3600},
+		{name: "fixed-offset", tzid: "Custom", offsets: []string{"-0430", "-0430"}, wantName: "Custom", wantOffset: -16200},
+		{name: "changing-offset", tzid: "Custom", offsets: []string{"+0100", "+0200"}, wantName: "UTC"},
+		{name: "unknown", tzid: "Mars/Olympus_Mons", wantName: "UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := resolveTZID(tt.tzid, tt.offsets)
+
+			// Assertions
+			assert.Equal(t, tt.wantName, got.String())
+			_, gotOffset := time.Date(2024, time.January, 15, 12, 0, 0, 0, got).Zone()
+			assert.Equal(t, tt.wantOffset, gotOffset)
+		})
+	}
+}
+
+func Test_parseICSDuration(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "PT1H30M", want: 90 * time.Minute},
+		{value: "P1D", want: 24 * time.Hour},
+		{value: "P1W", want: 7 * 24 * time.Hour},
+		{value: "-PT15M", want: -15 * time.Minute},
+		{value: "P1DT12H", want: 36 * time.Hour},
+		{value: "P", wantErr: true},
+		{value: "PT", wantErr: true},
+		{value: "1H", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			// Test
+			got, err := parseICSDuration(tt.value)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestMonthModel_View_ICS(t *testing.T) {
+	// Setup
+	styles := DefaultMonthStyles()
+	styles.DateStyles.Width = 12
+	styles.DateStyles.Height = 3
+	styles.DateStyles.BodyStyle = styles.DateStyles.BodyStyle.Width(12).Height(2)
+	tm := NewMonth(2024, time.September).
+		Location(mustLoadLocation("Europe/Berlin")).
+		Styles(styles).
+		Events(mustParseICSFile(t, "meetings.ics"))
+
+	// Test
+	got := ansi.Strip(tm.ViewWeeks())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
+
+func TestWriteICS(t *testing.T) {
+	// Setup
+	stamp := newTime(2024, time.September, 1, 12, 0)
+	berlin := mustLoadLocation("Europe/Berlin")
+	events := []Event{
+		{
+			UID:      "standup@example.com",
+			Title:    "Standup",
+			Location: "Room 4, Building B",
+			Category: "MEETING",
+			Start:    time.Date(2024, time.September, 10, 9, 30, 0, 0, berlin),
+			End:      time.Date(2024, time.September, 10, 9, 45, 0, 0, berlin),
+		},
+		{
+			Title: "Café break; with the whole team, and everyone else who would like to join us afterwards",
+			Start: newTime(2024, time.September, 12, 14, 0),
+		},
+		{
+			UID:    "offsite@example.com",
+			Title:  "Team offsite",
+			Start:  newDate(2024, time.September, 16),
+			End:    newDate(2024, time.September, 18),
+			AllDay: true,
+		},
+	}
+	var b strings.Builder
+
+	// Test
+	err := WriteICS(&b, events, stamp)
+
+	// Assertions
+	require.NoError(t, err)
+	golden.RequireEqual(t, []byte(b.String()))
+	assert.True(t, strings.HasSuffix(b.String(), "END:VCALENDAR\r\n"))
+	for _, line := range strings.Split(b.String(), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
+
+func TestWriteICS_RecurringTimeZone(t *testing.T) {
+	// Setup
+	newYork := mustLoadLocation("America/New_York")
+	events := []Event{
+		{
+			UID:   "standup@example.com",
+			Title: "Standup",
+			Start: time.Date(2024, time.September, 10, 9, 0, 0, 0, newYork),
+			End:   time.Date(2024, time.September, 10, 9, 15, 0, 0, newYork),
+			Recurrence: &Recurrence{
+				Frequency: FrequencyWeekly,
+				Until:     time.Date(2027, time.June, 30, 9, 0, 0, 0, newYork),
+			},
+		},
+	}
+	var b strings.Builder
+
+	// Test
+	err := WriteICS(&b, events, newTime(2024, time.September, 1, 12, 0))
+
+	// Assertions
+	require.NoError(t, err)
+	golden.RequireEqual(t, []byte(b.String()))
+	assert.Contains(t, b.String(), "DTSTART:20240310T020000\r\nRRULE:FREQ=YEARLY;BYDAY=2SU;BYMONTH=3\r\n")
+	assert.Contains(t, b.String(), "DTSTART:20241103T020000\r\nRRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11\r\n")
+}
+
+func Test_yearlyZoneChange(t *testing.T) {
+	tests := []struct {
+		name  string
+		loc   string
+		after time.Time
+		want  string
+	}{
+		{
+			name:  "nth-weekday",
+			loc:   "America/New_York",
+			after: newDate(2024, time.January, 1),
+			want:  "FREQ=YEARLY;BYDAY=2SU;BYMONTH=3",
+		},
+		{
+			name:  "last-weekday",
+			loc:   "Europe/Berlin",
+			after: newDate(2024, time.June, 1),
+			want:  "FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10",
+		},
+		{
+			name:  "southern-hemisphere",
+			loc:   "Australia/Sydney",
+			after: newDate(2024, time.January, 1),
+			want:  "FREQ=YEARLY;BYDAY=1SU;BYMONTH=4",
+		},
+		{
+			// Daylight saving time was abolished after 2022
+			name:  "abolished",
+			loc:   "Asia/Tehran",
+			after: newDate(2022, time.January, 1),
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			loc := mustLoadLocation(tt.loc)
+			after := tt.after.In(loc)
+			_, offset := after.Zone()
+			onset, ok := nextZoneChange(after, after.AddDate(1, 0, 0))
+			require.True(t, ok)
+
+			// Test
+			got := yearlyZoneChange(onset, offset)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWriteICS_RoundTrip(t *testing.T) {
+	// Setup
+	events := mustParseICSFile(t, "meetings.ics")
+	var b strings.Builder
+
+	// Test
+	err := WriteICS(&b, events, time.Now())
+	require.NoError(t, err)
+	got, err := ParseICS(strings.NewReader(b.String()))
+
+	// Assertions
+	require.NoError(t, err)
+	want := slices.Clone(events)
+	// Single-day events are written with an explicit end
+	want[3].End = newDate(2024, time.October, 4)
+	assert.Equal(t, want, got)
+}
+
+func Test_formatICSOffset(t *testing.T) {
+	tests := []struct {
+		offset int
+		want   string
+	}{
+		{offset: 0, want: "+0000"},
+		{offset: 3600, want: "+0100"},
+		{offset: -(4*3600 + 30*60), want: "-0430"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			// Test
+			got := formatICSOffset(tt.offset)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMonthModel_VisibleEvents(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September)
+
+	// Test
+	gotNone := tm.VisibleEvents()
+	got := tm.Events(testEvents()).NextMonth().VisibleEvents()
+
+	// Assertions
+	assert.Nil(t, gotNone)
+	assert.Empty(t, got)
+	assert.Len(t, tm.Events(testEvents()).VisibleEvents(), 3)
+}
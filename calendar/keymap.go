@@ -10,6 +10,27 @@ type KeyMap struct {
 	Right key.Binding
 	Up    key.Binding
 	Down  key.Binding
+
+	PreviousMonth key.Binding
+	NextMonth     key.Binding
+	PreviousYear  key.Binding
+	NextYear      key.Binding
+
+	PreviousWeek key.Binding
+	NextWeek     key.Binding
+
+	ScrollUp   key.Binding
+	ScrollDown key.Binding
+
+	EnterDay key.Binding
+	ExitDay  key.Binding
+
+	Select key.Binding
+	Anchor key.Binding
+	Cancel key.Binding
+	Toggle key.Binding
+	Focus  key.Binding
+	Today  key.Binding
 }
 
 // DefaultMonthKeyMap contains default key mappings for monthly navigation.
@@ -19,6 +40,24 @@ func DefaultMonthKeyMap() KeyMap {
 		Right: key.NewBinding(key.WithKeys("right"), key.WithHelp("right", "→")),
 		Up:    key.NewBinding(key.WithKeys("up"), key.WithHelp("up", "↑")),
 		Down:  key.NewBinding(key.WithKeys("down"), key.WithHelp("down", "↓")),
+
+		PreviousMonth: key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "previous month")),
+		NextMonth:     key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "next month")),
+		PreviousYear:  key.NewBinding(key.WithKeys("ctrl+pgup"), key.WithHelp("ctrl+pgup", "previous year")),
+		NextYear:      key.NewBinding(key.WithKeys("ctrl+pgdown"), key.WithHelp("ctrl+pgdown", "next year")),
+
+		Today: key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "today")),
+
+		ScrollUp:   key.NewBinding(key.WithKeys("shift+up"), key.WithHelp("shift+up", "scroll date up")),
+		ScrollDown: key.NewBinding(key.WithKeys("shift+down"), key.WithHelp("shift+down", "scroll date down")),
+
+		EnterDay: key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "enter day")),
+		ExitDay:  key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "exit day")),
+
+		Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		Anchor: key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "anchor range")),
+		Cancel: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		Toggle: key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle date")),
 	}
 }
 
@@ -27,5 +66,86 @@ func DefaultWeekKeyMap() KeyMap {
 	return KeyMap{
 		Left:  key.NewBinding(key.WithKeys("left"), key.WithHelp("left", "←")),
 		Right: key.NewBinding(key.WithKeys("right"), key.WithHelp("right", "→")),
+		Up:    key.NewBinding(key.WithKeys("up"), key.WithHelp("up", "↑")),
+		Down:  key.NewBinding(key.WithKeys("down"), key.WithHelp("down", "↓")),
+
+		PreviousWeek: key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "previous week")),
+		NextWeek:     key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "next week")),
+
+		Today: key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "today")),
+
+		ScrollUp:   key.NewBinding(key.WithKeys("shift+up"), key.WithHelp("shift+up", "scroll date up")),
+		ScrollDown: key.NewBinding(key.WithKeys("shift+down"), key.WithHelp("shift+down", "scroll date down")),
+
+		EnterDay: key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "enter day")),
+		ExitDay:  key.NewBinding(key.WithKeys("ctrl+x"), key.WithHelp("ctrl+x", "exit day")),
+
+		Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		Anchor: key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "anchor range")),
+		Cancel: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		Toggle: key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle date")),
+	}
+}
+
+// DefaultYearKeyMap contains default key mappings for yearly navigation.
+func DefaultYearKeyMap() KeyMap {
+	return KeyMap{
+		Left:  key.NewBinding(key.WithKeys("left"), key.WithHelp("left", "←")),
+		Right: key.NewBinding(key.WithKeys("right"), key.WithHelp("right", "→")),
+		Up:    key.NewBinding(key.WithKeys("up"), key.WithHelp("up", "↑")),
+		Down:  key.NewBinding(key.WithKeys("down"), key.WithHelp("down", "↓")),
+
+		PreviousMonth: key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "previous month")),
+		NextMonth:     key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "next month")),
+		PreviousYear:  key.NewBinding(key.WithKeys("ctrl+pgup"), key.WithHelp("ctrl+pgup", "previous year")),
+		NextYear:      key.NewBinding(key.WithKeys("ctrl+pgdown"), key.WithHelp("ctrl+pgdown", "next year")),
+	}
+}
+
+// DefaultHeatmapKeyMap contains default key mappings for heatmap navigation, where rows are weekdays and columns are
+// weeks.
+func DefaultHeatmapKeyMap() KeyMap {
+	return KeyMap{
+		Left:  key.NewBinding(key.WithKeys("left"), key.WithHelp("left", "←")),
+		Right: key.NewBinding(key.WithKeys("right"), key.WithHelp("right", "→")),
+		Up:    key.NewBinding(key.WithKeys("up"), key.WithHelp("up", "↑")),
+		Down:  key.NewBinding(key.WithKeys("down"), key.WithHelp("down", "↓")),
+
+		PreviousYear: key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "previous period")),
+		NextYear:     key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "next period")),
+	}
+}
+
+// DefaultDayKeyMap contains default key mappings for daily navigation.
+func DefaultDayKeyMap() KeyMap {
+	return KeyMap{
+		Up:   key.NewBinding(key.WithKeys("up"), key.WithHelp("up", "↑")),
+		Down: key.NewBinding(key.WithKeys("down"), key.WithHelp("down", "↓")),
+
+		ScrollUp:   key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "scroll up")),
+		ScrollDown: key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "scroll down")),
+
+		Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+	}
+}
+
+// DefaultDatePickerKeyMap contains default key mappings for date picking.
+func DefaultDatePickerKeyMap() KeyMap {
+	return KeyMap{
+		Left:  key.NewBinding(key.WithKeys("left"), key.WithHelp("left", "←")),
+		Right: key.NewBinding(key.WithKeys("right"), key.WithHelp("right", "→")),
+		Up:    key.NewBinding(key.WithKeys("up"), key.WithHelp("up", "↑")),
+		Down:  key.NewBinding(key.WithKeys("down"), key.WithHelp("down", "↓")),
+
+		PreviousMonth: key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "previous month")),
+		NextMonth:     key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "next month")),
+		PreviousYear:  key.NewBinding(key.WithKeys("ctrl+pgup"), key.WithHelp("ctrl+pgup", "previous year")),
+		NextYear:      key.NewBinding(key.WithKeys("ctrl+pgdown"), key.WithHelp("ctrl+pgdown", "next year")),
+
+		Today: key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "today")),
+
+		Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "pick date")),
+		Cancel: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		Focus:  key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "switch focus")),
 	}
 }
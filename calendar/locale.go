@@ -0,0 +1,368 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+)
+
+// Locale provides the names, labels and date formats used to render calendars for a language and region.
+type Locale struct {
+	// Tag is the BCP 47 language tag of the locale, e.g. "en-US"
+	Tag string
+
+	// Full and abbreviated month names, indexed by time.Month-1
+	MonthNames      [12]string
+	ShortMonthNames [12]string
+
+	// Full weekday names, indexed by time.Weekday
+	WeekdayNames [7]string
+
+	// Weekday labels for calendar headers
+	Weekdays Weekdays
+	// Narrow weekday labels for compact calendars
+	ShortWeekdays Weekdays
+
+	// FirstDayOfWeek is the day that represents the beginning of the week
+	FirstDayOfWeek time.Weekday
+
+	// WeekNumbering determines how weeks are numbered when week numbers are shown
+	WeekNumbering WeekNumbering
+	// WeekNumberLabel heads the week number column
+	WeekNumberLabel string
+
+	// Layouts, as understood by Format, for a month title, a short date in column headers, and a long date in
+	// timeline headers
+	TitleFormat     string
+	ShortDateFormat string
+	LongDateFormat  string
+}
+
+// Format returns a textual representation of the time, as time.Format does, except that the "January", "Jan",
+// "Monday" and "Mon" layout elements are replaced with the locale's names.
+func (l Locale) Format(t time.Time, layout string) string {
+	var b strings.Builder
+	start := 0
+	for i := 0; i < len(layout); {
+		name, n := l.name(t, layout[i:])
+		if n == 0 {
+			i++
+			continue
+		}
+
+		b.WriteString(t.Format(layout[start:i]))
+		b.WriteString(name)
+		i += n
+		start = i
+	}
+	b.WriteString(t.Format(layout[start:]))
+
+	return b.String()
+}
+
+// name finds the localized name for a month or weekday layout element at the start of the layout. If the layout does
+// not start with a name element, zero is returned as the element length.
+func (l Locale) name(t time.Time, layout string) (string, int) {
+	var name, element string
+	switch {
+	case strings.HasPrefix(layout, "January"):
+		name, element = l.MonthNames[t.Month()-1], "January"
+	case strings.HasPrefix(layout, "Jan"):
+		name, element = l.ShortMonthNames[t.Month()-1], "Jan"
+	case strings.HasPrefix(layout, "Monday"):
+		name, element = l.WeekdayNames[t.Weekday()], "Monday"
+	case strings.HasPrefix(layout, "Mon"):
+		name, element = l.Weekdays[t.Weekday()], "Mon"
+	default:
+		return "", 0
+	}
+
+	// Fall back to English for names the locale does not provide
+	if name == "" {
+		name = t.Format(element)
+	}
+	return name, len(element)
+}
+
+// MonthName returns the full name of a month.
+func (l Locale) MonthName(month time.Month) string {
+	if name := l.MonthNames[month-1]; name != "" {
+		return name
+	}
+	return month.String()
+}
+
+// DefaultLocale returns the locale used when no other locale is set, which is US English.
+func DefaultLocale() Locale {
+	return LocaleEnUS()
+}
+
+// LookupLocale finds a built-in locale by its BCP 47 language tag, e.g. "en-GB" or "de". Tags are matched without
+// regard to case or separator, and a tag with an unknown region falls back to its language.
+func LookupLocale(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+
+	locales := map[string]func() Locale{
+		"en":    LocaleEnUS,
+		"en-us": LocaleEnUS,
+		"en-gb": LocaleEnGB,
+		"de":    LocaleDe,
+		"fr":    LocaleFr,
+		"es":    LocaleEs,
+		"ja":    LocaleJa,
+	}
+	if l, ok := locales[tag]; ok {
+		return l(), true
+	}
+	language, _, _ := strings.Cut(tag, "-")
+	if l, ok := locales[language]; ok {
+		return l(), true
+	}
+	return Locale{}, false
+}
+
+// englishMonthNames are the full English month names.
+var englishMonthNames = [12]string{
+	"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December",
+}
+
+// englishShortMonthNames are the abbreviated English month names.
+var englishShortMonthNames = [12]string{
+	"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+	"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+}
+
+// englishWeekdayNames are the full English weekday names.
+var englishWeekdayNames = [7]string{
+	"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+}
+
+// LocaleEnUS returns the US English locale.
+func LocaleEnUS() Locale {
+	return Locale{
+		Tag: "en-US",
+
+		MonthNames:      englishMonthNames,
+		ShortMonthNames: englishShortMonthNames,
+		WeekdayNames:    englishWeekdayNames,
+
+		Weekdays:      DefaultWeekdays(),
+		ShortWeekdays: DefaultWeekdaysShort(),
+
+		FirstDayOfWeek:  time.Sunday,
+		WeekNumbering:   WeekNumberingJanuaryFirst,
+		WeekNumberLabel: "Wk",
+
+		TitleFormat:     "January 2006",
+		ShortDateFormat: "1/02",
+		LongDateFormat:  "Monday, January 2",
+	}
+}
+
+// LocaleEnGB returns the British English locale.
+func LocaleEnGB() Locale {
+	return Locale{
+		Tag: "en-GB",
+
+		MonthNames:      englishMonthNames,
+		ShortMonthNames: englishShortMonthNames,
+		WeekdayNames:    englishWeekdayNames,
+
+		Weekdays: DefaultWeekdays(),
+		ShortWeekdays: Weekdays{
+			time.Sunday:    "S",
+			time.Monday:    "M",
+			time.Tuesday:   "T",
+			time.Wednesday: "W",
+			time.Thursday:  "T",
+			time.Friday:    "F",
+			time.Saturday:  "S",
+		},
+
+		FirstDayOfWeek:  time.Monday,
+		WeekNumbering:   WeekNumberingISO,
+		WeekNumberLabel: "Wk",
+
+		TitleFormat:     "January 2006",
+		ShortDateFormat: "02/01",
+		LongDateFormat:  "Monday 2 January",
+	}
+}
+
+// LocaleDe returns the German locale.
+func LocaleDe() Locale {
+	return Locale{
+		Tag: "de",
+
+		MonthNames: [12]string{
+			"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember",
+		},
+		ShortMonthNames: [12]string{
+			"Jan", "Feb", "Mär", "Apr", "Mai", "Jun",
+			"Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
+		},
+		WeekdayNames: [7]string{
+			"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+		},
+
+		Weekdays: Weekdays{
+			time.Sunday:    "So",
+			time.Monday:    "Mo",
+			time.Tuesday:   "Di",
+			time.Wednesday: "Mi",
+			time.Thursday:  "Do",
+			time.Friday:    "Fr",
+			time.Saturday:  "Sa",
+		},
+		ShortWeekdays: Weekdays{
+			time.Sunday:    "S",
+			time.Monday:    "M",
+			time.Tuesday:   "D",
+			time.Wednesday: "M",
+			time.Thursday:  "D",
+			time.Friday:    "F",
+			time.Saturday:  "S",
+		},
+
+		FirstDayOfWeek:  time.Monday,
+		WeekNumbering:   WeekNumberingISO,
+		WeekNumberLabel: "KW",
+
+		TitleFormat:     "January 2006",
+		ShortDateFormat: "02.01.",
+		LongDateFormat:  "Monday, 2. January",
+	}
+}
+
+// LocaleFr returns the French locale.
+func LocaleFr() Locale {
+	return Locale{
+		Tag: "fr",
+
+		MonthNames: [12]string{
+			"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+		},
+		ShortMonthNames: [12]string{
+			"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+		},
+		WeekdayNames: [7]string{
+			"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+		},
+
+		Weekdays: Weekdays{
+			time.Sunday:    "dim",
+			time.Monday:    "lun",
+			time.Tuesday:   "mar",
+			time.Wednesday: "mer",
+			time.Thursday:  "jeu",
+			time.Friday:    "ven",
+			time.Saturday:  "sam",
+		},
+		ShortWeekdays: Weekdays{
+			time.Sunday:    "D",
+			time.Monday:    "L",
+			time.Tuesday:   "M",
+			time.Wednesday: "M",
+			time.Thursday:  "J",
+			time.Friday:    "V",
+			time.Saturday:  "S",
+		},
+
+		FirstDayOfWeek:  time.Monday,
+		WeekNumbering:   WeekNumberingISO,
+		WeekNumberLabel: "Sem.",
+
+		TitleFormat:     "January 2006",
+		ShortDateFormat: "02/01",
+		LongDateFormat:  "Monday 2 January",
+	}
+}
+
+// LocaleEs returns the Spanish locale.
+func LocaleEs() Locale {
+	return Locale{
+		Tag: "es",
+
+		MonthNames: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		ShortMonthNames: [12]string{
+			"ene", "feb", "mar", "abr", "may", "jun",
+			"jul", "ago", "sept", "oct", "nov", "dic",
+		},
+		WeekdayNames: [7]string{
+			"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado",
+		},
+
+		Weekdays: Weekdays{
+			time.Sunday:    "dom",
+			time.Monday:    "lun",
+			time.Tuesday:   "mar",
+			time.Wednesday: "mié",
+			time.Thursday:  "jue",
+			time.Friday:    "vie",
+			time.Saturday:  "sáb",
+		},
+		ShortWeekdays: Weekdays{
+			time.Sunday:    "D",
+			time.Monday:    "L",
+			time.Tuesday:   "M",
+			time.Wednesday: "X",
+			time.Thursday:  "J",
+			time.Friday:    "V",
+			time.Saturday:  "S",
+		},
+
+		FirstDayOfWeek:  time.Monday,
+		WeekNumbering:   WeekNumberingISO,
+		WeekNumberLabel: "Sem",
+
+		TitleFormat:     "January de 2006",
+		ShortDateFormat: "02/01",
+		LongDateFormat:  "Monday, 2 de January",
+	}
+}
+
+// LocaleJa returns the Japanese locale.
+func LocaleJa() Locale {
+	weekdays := Weekdays{
+		time.Sunday:    "日",
+		time.Monday:    "月",
+		time.Tuesday:   "火",
+		time.Wednesday: "水",
+		time.Thursday:  "木",
+		time.Friday:    "金",
+		time.Saturday:  "土",
+	}
+
+	return Locale{
+		Tag: "ja",
+
+		MonthNames: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		ShortMonthNames: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		WeekdayNames: [7]string{
+			"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
+		},
+
+		Weekdays:      weekdays,
+		ShortWeekdays: weekdays,
+
+		FirstDayOfWeek:  time.Sunday,
+		WeekNumbering:   WeekNumberingJanuaryFirst,
+		WeekNumberLabel: "週",
+
+		TitleFormat:     "2006年January",
+		ShortDateFormat: "1/2",
+		LongDateFormat:  "1月2日(Mon)",
+	}
+}
@@ -0,0 +1,168 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_Format(t *testing.T) {
+	date := newDate(2024, time.September, 10)
+
+	tests := []struct {
+		name      string
+		locale    Locale
+		wantTitle string
+		wantShort string
+		wantLong  string
+	}{
+		{
+			name:      "en-US",
+			locale:    LocaleEnUS(),
+			wantTitle: "September 2024",
+			wantShort: "9/10",
+			wantLong:  "Tuesday, September 10",
+		},
+		{
+			name:      "en-GB",
+			locale:    LocaleEnGB(),
+			wantTitle: "September 2024",
+			wantShort: "10/09",
+			wantLong:  "Tuesday 10 September",
+		},
+		{
+			name:      "de",
+			locale:    LocaleDe(),
+			wantTitle: "September 2024",
+			wantShort: "10.09.",
+			wantLong:  "Dienstag, 10. September",
+		},
+		{
+			name:      "fr",
+			locale:    LocaleFr(),
+			wantTitle: "septembre 2024",
+			wantShort: "10/09",
+			wantLong:  "mardi 10 septembre",
+		},
+		{
+			name:      "es",
+			locale:    LocaleEs(),
+			wantTitle: "septiembre de 2024",
+			wantShort: "10/09",
+			wantLong:  "martes, 10 de septiembre",
+		},
+		{
+			name:      "ja",
+			locale:    LocaleJa(),
+			wantTitle: "2024年9月",
+			wantShort: "9/10",
+			wantLong:  "9月10日(火)",
+		},
+		{
+			name:      "empty",
+			locale:    Locale{LongDateFormat: "Mon Jan 2"},
+			wantTitle: "",
+			wantShort: "",
+			wantLong:  "Tue Sep 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			gotTitle := tt.locale.Format(date, tt.locale.TitleFormat)
+			gotShort := tt.locale.Format(date, tt.locale.ShortDateFormat)
+			gotLong := tt.locale.Format(date, tt.locale.LongDateFormat)
+
+			// Assertions
+			assert.Equal(t, tt.wantTitle, gotTitle)
+			assert.Equal(t, tt.wantShort, gotShort)
+			assert.Equal(t, tt.wantLong, gotLong)
+		})
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		tag     string
+		wantTag string
+		wantOk  bool
+	}{
+		{tag: "en-US", wantTag: "en-US", wantOk: true},
+		{tag: "en_gb", wantTag: "en-GB", wantOk: true},
+		{tag: "de-AT", wantTag: "de", wantOk: true},
+		{tag: "ja", wantTag: "ja", wantOk: true},
+		{tag: "pt-BR", wantTag: "", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			// Test
+			got, gotOk := LookupLocale(tt.tag)
+
+			// Assertions
+			assert.Equal(t, tt.wantTag, got.Tag)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
+
+func TestMonthModel_Locale(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September)
+
+	// Test
+	got := tm.Locale(LocaleDe())
+
+	// Assertions
+	assert.Equal(t, time.Monday, got.startOfWeek)
+	assert.Equal(t, LocaleDe().Weekdays, got.weekdays)
+	assert.Equal(t, "September 2024", got.Title(true))
+	assert.Equal(t, "Oktober", got.NextMonth().Title(false))
+}
+
+func TestWeekModel_Locale(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.September, 24))
+
+	// Test
+	got := tm.Locale(LocaleJa())
+
+	// Assertions
+	assert.Equal(t, time.Sunday, got.startOfWeek)
+	assert.Equal(t, newDate(2024, time.September, 22), got.startDate)
+	assert.Equal(t, LocaleJa().Weekdays, got.weekdays)
+}
+
+func TestWeekModel_View_Locale(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale Locale
+	}{
+		{
+			name:   "en-GB",
+			locale: LocaleEnGB(),
+		},
+		{
+			name:   "fr",
+			locale: LocaleFr(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewWeek(newDate(2024, time.September, 24)).Locale(tt.locale)
+			_ = tm.Init()
+
+			// Test
+			got := ansi.Strip(tm.ViewHeaders())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
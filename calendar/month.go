@@ -1,12 +1,15 @@
 package calendar
 
 import (
+	"cmp"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // DayContentMsg enables updates for the content of a single day.
@@ -24,8 +27,20 @@ type ActiveDateMsg struct {
 	Date time.Time
 }
 
+// MonthChangedMsg notifies to other models that the MonthModel now represents a different month.
+type MonthChangedMsg struct {
+	// Year of the month now represented
+	Year int
+
+	// Month now represented
+	Month time.Month
+}
+
 // MonthModel represents a full calendar month.
 type MonthModel struct {
+	// id routes midnight ticks back to this model
+	id int64
+
 	// keyMap is key bindings for calendar navigation
 	keyMap KeyMap
 
@@ -35,16 +50,73 @@ type MonthModel struct {
 	// weekdays manages labels for weekdays
 	weekdays Weekdays
 
+	// locale provides month names
+	locale Locale
+
+	// location is the time zone in which dates are constructed and incoming times are interpreted
+	location *time.Location
+
 	// year of the month represented
 	year int
 	// month to represent
 	month time.Month
 
 	// days contains user-provided information about each day
-	days map[int]tea.Model
+	days map[time.Time]tea.Model
+
+	// events provides the events shown on days without content
+	events EventSource
+	// eventRenderer renders the events of a day
+	eventRenderer EventRenderer
 
 	activeDay int
 
+	// bounds restricts which dates may be active
+	bounds dateBounds
+
+	// selection tracks selected dates
+	selection selection
+
+	// clock provides the current time, which determines today's date
+	clock func() time.Time
+	// refreshAtMidnight enables a tick that reports the new current date at midnight
+	refreshAtMidnight bool
+
+	// showWeekNumbers enables the leading week number column
+	showWeekNumbers bool
+
+	// dateStyleFunc provides optional per-date styling
+	dateStyleFunc DateStyleFunc
+
+	// holidays provides the holidays shown on dates
+	holidays HolidayProvider
+	// showHolidayLabels enables the name of each holiday at the top of its date, below the bars of multi-day events
+	showHolidayLabels bool
+	// skipHolidays prevents holidays from becoming active
+	skipHolidays bool
+
+	// offsetX and offsetY are the position of the view within the terminal, which locates mouse events
+	offsetX int
+	offsetY int
+	// clicks recognizes double clicks
+	clicks clickTracker
+
+	// width and height are the target size of the view, or zero to use the size of the styles
+	width  int
+	height int
+	// fitWindow sizes the view to the terminal window
+	fitWindow bool
+	// sized marks a copy whose styles are already fitted to the target size
+	sized bool
+
+	// overflow determines how content that does not fit a date is shown
+	overflow Overflow
+	// scroll is the scroll offset of the active date's content
+	scroll int
+
+	// focusedDate is the date whose content receives key presses, or the zero time while no content has focus
+	focusedDate time.Time
+
 	// Styles
 	styles MonthStyles
 }
@@ -52,6 +124,7 @@ type MonthModel struct {
 // NewMonth creates a new MonthModel.
 func NewMonth(year int, month time.Month) MonthModel {
 	m := MonthModel{
+		id:     nextID(),
 		keyMap: DefaultMonthKeyMap(),
 
 		year:  year,
@@ -59,8 +132,14 @@ func NewMonth(year int, month time.Month) MonthModel {
 
 		startOfWeek: time.Sunday,
 		weekdays:    DefaultWeekdays(),
+		locale:      DefaultLocale(),
+		location:    time.UTC,
+
+		days: make(map[time.Time]tea.Model),
+
+		eventRenderer: EventListRenderer,
 
-		days: make(map[int]tea.Model),
+		clock: time.Now,
 
 		styles: DefaultMonthStyles(),
 	}
@@ -80,116 +159,556 @@ func (m MonthModel) Weekdays(weekdays Weekdays) MonthModel {
 	return m
 }
 
+// Locale sets the month names, weekday labels and first day of the week.
+//
+// Weekday labels and the first day of the week may still be overridden afterwards with Weekdays and StartOfWeek.
+func (m MonthModel) Locale(locale Locale) MonthModel {
+	m.locale = locale
+	m.weekdays = locale.Weekdays
+	m.startOfWeek = locale.FirstDayOfWeek
+	return m
+}
+
+// Location sets the time zone in which dates are constructed, which defaults to UTC. A nil location is treated as UTC.
+//
+// Times received in messages, such as DayContentMsg, are converted to the location to find the date they fall on, and
+// dates reported in messages, such as ActiveDateMsg, are midnight in the location. Dates that are already set, e.g.
+// content, bounds and selected dates, keep their calendar date.
+func (m MonthModel) Location(loc *time.Location) MonthModel {
+	if loc == nil {
+		loc = time.UTC
+	}
+	m.location = loc
+
+	days := make(map[time.Time]tea.Model, len(m.days))
+	for date, content := range m.days {
+		days[sameDateIn(date, loc)] = content
+	}
+	m.days = days
+	m.focusedDate = sameDateIn(m.focusedDate, loc)
+	m.bounds = m.bounds.in(loc)
+	m.selection = m.selection.in(loc)
+
+	return m
+}
+
+// Events sets the source of the events shown in the body of each day, which is queried for the days of the month each
+// time the month is rendered. Days with content set by a DayContentMsg show their content instead.
+func (m MonthModel) Events(source EventSource) MonthModel {
+	m.events = source
+	return m
+}
+
+// VisibleEvents returns the events of the represented month, as provided by the event source, e.g. to be written with
+// WriteICS. If the source is a RecurringEventSource, such as an EventList, recurring events are returned once with
+// their recurrence rather than as each of their occurrences. Content set with a DayContentMsg is not included.
+func (m MonthModel) VisibleEvents() []Event {
+	first := m.date(1)
+	return visibleEvents(m.events, first, first.AddDate(0, 1, 0))
+}
+
+// EventRenderer sets how the events of a day are rendered. Defaults to EventListRenderer.
+func (m MonthModel) EventRenderer(renderer EventRenderer) MonthModel {
+	m.eventRenderer = renderer
+	return m
+}
+
+// WeekNumbers enables or disables a column to the left of the month showing the number of each week, as determined by
+// the locale's week numbering.
+func (m MonthModel) WeekNumbers(enabled bool) MonthModel {
+	m.showWeekNumbers = enabled
+	return m
+}
+
+// DateStyleFunc sets a function that provides styling for the number of individual dates.
+func (m MonthModel) DateStyleFunc(f DateStyleFunc) MonthModel {
+	m.dateStyleFunc = f
+	return m
+}
+
+// Holidays sets the provider of the holidays shown on dates, such as USFederalHolidays. Holidays are rendered with the
+// holiday number style.
+func (m MonthModel) Holidays(provider HolidayProvider) MonthModel {
+	m.holidays = provider
+	return m.SkipHolidays(m.skipHolidays)
+}
+
+// HolidayLabels enables or disables the name of each holiday at the top of its date, below the bars of multi-day
+// events.
+func (m MonthModel) HolidayLabels(enabled bool) MonthModel {
+	m.showHolidayLabels = enabled
+	return m
+}
+
+// SkipHolidays enables or disables skipping holidays when navigating, in which case holidays may not be active.
+func (m MonthModel) SkipHolidays(enabled bool) MonthModel {
+	m.skipHolidays = enabled
+	m.bounds.holidays = nil
+	if enabled {
+		m.bounds.holidays = m.holidays
+	}
+	return m
+}
+
+// Holiday finds the holiday on a date, if any.
+func (m MonthModel) Holiday(date time.Time) (Holiday, bool) {
+	return holidayOn(m.holidays, dateIn(date, m.location))
+}
+
+// Offset sets the position of the top-left corner of the view within the terminal, so that mouse events may be mapped
+// to dates. Defaults to the top-left corner of the terminal.
+func (m MonthModel) Offset(x int, y int) MonthModel {
+	m.offsetX = x
+	m.offsetY = y
+	return m
+}
+
+// Size sets the target width and height of the rendered calendar, to which the dates are fitted. If bordered dates
+// would be too narrow for the weekday labels, short weekday labels are used, and if they would still be too narrow or
+// too short for the date numbers, a compact borderless layout is used instead. Zero keeps the width or height of the
+// styles.
+func (m MonthModel) Size(width int, height int) MonthModel {
+	m.width = max(0, width)
+	m.height = max(0, height)
+	return m
+}
+
+// FitWindow enables or disables sizing the calendar to the terminal window whenever a tea.WindowSizeMsg is received.
+func (m MonthModel) FitWindow(enabled bool) MonthModel {
+	m.fitWindow = enabled
+	return m
+}
+
+// fit adapts the styles and weekday labels to the target size, if one is set.
+func (m MonthModel) fit() MonthModel {
+	if m.sized || ((m.width == 0) && (m.height == 0)) {
+		return m
+	}
+	m.sized = true
+
+	columns := visibleWeekdays(m.weekdays)
+	rows := m.weekRows()
+	left := 0
+	if m.showWeekNumbers {
+		left = gloss.Width(m.styles.WeekNumberStyle.Render(""))
+	}
+
+	// Bordered dates share borders with their neighbors, so each date has a single border on the right and bottom, and
+	// the first column has an extra border on the left
+	width, height := m.styles.DateStyles.Width, m.styles.DateStyles.Height
+	frame := m.styles.MiddleDayStyle.GetHorizontalFrameSize()
+	first := m.styles.MiddleLeftDayStyle.GetHorizontalFrameSize() - frame
+	if m.width > 0 {
+		width = (m.width-left-first)/columns - frame
+	}
+	if m.height > 0 {
+		headers := gloss.Height(m.styles.LeftHeaderStyle.Render(""))
+		height = (m.height-headers)/rows - m.styles.MiddleDayStyle.GetVerticalFrameSize()
+	}
+	// Short weekday labels are tried before giving up the borders, and date numbers need two columns
+	padding := m.styles.MiddleHeaderStyle.GetHorizontalPadding()
+	short := shortWeekdays(m.weekdays, m.locale)
+	if (width >= maxLabelWidth(m.weekdays)+padding) && (height >= 1) {
+		m.styles.DateStyles = m.styles.DateStyles.resized(width, height)
+		return m
+	}
+	if (width >= max(2, maxLabelWidth(short)+padding)) && (height >= 1) {
+		m.weekdays = short
+		m.styles.DateStyles = m.styles.DateStyles.resized(width, height)
+		return m
+	}
+
+	// Compact dates fill the space without borders, with numbers and labels aligned to the right so that they do not
+	// run together
+	m.weekdays = short
+	for _, style := range []*gloss.Style{
+		&m.styles.LeftHeaderStyle, &m.styles.MiddleHeaderStyle, &m.styles.RightHeaderStyle,
+	} {
+		*style = borderless(*style).Align(gloss.Right)
+	}
+	for _, style := range []*gloss.Style{
+		&m.styles.MiddleLeftDayStyle, &m.styles.MiddleDayStyle, &m.styles.MiddleRightDayStyle,
+		&m.styles.BottomLeftDayStyle, &m.styles.BottomDayStyle, &m.styles.BottomRightDayStyle,
+	} {
+		*style = borderless(*style)
+	}
+	m.styles.WeekNumberHeaderStyle = m.styles.WeekNumberHeaderStyle.PaddingTop(0)
+
+	width, height = m.styles.DateStyles.Width, m.styles.DateStyles.Height
+	if m.width > 0 {
+		width = (m.width - left) / columns
+	}
+	if m.height > 0 {
+		height = (m.height - 1) / rows
+	}
+	m.styles.DateStyles = m.styles.DateStyles.resized(width, height)
+	for _, style := range m.styles.DateStyles.numberStyles() {
+		*style = style.Align(gloss.Right)
+	}
+
+	return m
+}
+
+// weekRows calculates the number of week rows rendered for the month. If the first week starts in the previous month
+// and the last visible day of that week is still in the previous month, the week is not rendered.
+func (m MonthModel) weekRows() int {
+	rows := CalendarRowsInMonth(m.year, m.month, m.startOfWeek)
+
+	calendarStartDate := m.StartOfFirstWeek()
+	spread := m.weekdays.Spread(calendarStartDate)
+	if calendarStartDate.AddDate(0, 0, spread).Month() < m.month {
+		rows -= 1
+	}
+	return rows
+}
+
+// Overflow sets how content and events that do not fit within a date are shown. Defaults to OverflowNone.
+func (m MonthModel) Overflow(overflow Overflow) MonthModel {
+	m.overflow = overflow
+	m.scroll = 0
+	return m
+}
+
 // Styles sets custom styling.
 func (m MonthModel) Styles(styles MonthStyles) MonthModel {
 	m.styles = styles
 	return m
 }
 
-// Init the MonthModel.
-func (m MonthModel) Init() tea.Cmd { return nil }
+// MinDate sets the first date that may be active. The zero time removes the minimum.
+func (m MonthModel) MinDate(date time.Time) MonthModel {
+	m.bounds.minDate = dateIn(date, m.location)
+	if date == (time.Time{}) {
+		m.bounds.minDate = time.Time{}
+	}
+	return m
+}
 
-// Update the MonthModel.
-func (m MonthModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	firstVisibleWeekday := m.weekdays.First(time.Date(m.year, m.month, 1, 0, 0, 0, 0, time.UTC))
+// MaxDate sets the last date that may be active. The zero time removes the maximum.
+func (m MonthModel) MaxDate(date time.Time) MonthModel {
+	m.bounds.maxDate = dateIn(date, m.location)
+	if date == (time.Time{}) {
+		m.bounds.maxDate = time.Time{}
+	}
+	return m
+}
+
+// DisabledFunc sets a function that reports dates that may not be active, e.g. blackout days.
+//
+// Disabled dates are skipped when navigating and are rendered with the disabled number style.
+func (m MonthModel) DisabledFunc(f func(date time.Time) bool) MonthModel {
+	m.bounds.disabled = f
+	return m
+}
+
+// IsDisabled determines if a date may not be active, either because it falls outside of the minimum and maximum
+// dates, because it is a skipped holiday or because it is disabled.
+func (m MonthModel) IsDisabled(date time.Time) bool {
+	return m.bounds.isDisabled(dateIn(date, m.location))
+}
+
+// DateState describes a date as it is rendered, as passed to the DateStyleFunc.
+func (m MonthModel) DateState(date time.Time) DateState {
+	date = dateIn(date, m.location)
+	today := m.Today()
+	_, isHoliday := holidayOn(m.holidays, date)
+	return DateState{
+		Active:   (m.activeDay > 0) && date.Equal(m.ActiveDate()),
+		Today:    date.Equal(today),
+		Past:     date.Before(today),
+		Selected: m.selection.position(date, m.ActiveDate()) != notSelected,
+		Disabled: m.IsDisabled(date),
+		Holiday:  isHoliday,
+	}
+}
+
+// Clock sets the source of the current time, which determines today's date. Defaults to time.Now.
+func (m MonthModel) Clock(now func() time.Time) MonthModel {
+	m.clock = now
+	return m
+}
+
+// RefreshAtMidnight enables or disables a tick, started by Init, that sends a TodayMsg at every midnight so that
+// today's date is re-rendered without any other input.
+func (m MonthModel) RefreshAtMidnight(enabled bool) MonthModel {
+	m.refreshAtMidnight = enabled
+	return m
+}
+
+// Today returns today's date, according to the clock.
+func (m MonthModel) Today() time.Time {
+	return today(m.clock, m.location)
+}
+
+// JumpToToday moves the calendar to the current month and sets today as the active date.
+//
+// If today may not be active, the nearest date in the current month that may be active is used instead.
+func (m MonthModel) JumpToToday() MonthModel {
+	m = m.setActiveDate(m.Today())
+	m.activeDay = m.nearestSelectableDay(m.activeDay)
+	return m
+}
+
+// Selection sets how dates may be selected.
+func (m MonthModel) Selection(mode SelectionMode) MonthModel {
+	m.selection = selection{mode: mode}
+	return m
+}
+
+// SelectedRange returns the range of dates currently being selected. If no range is in progress, ok is false.
+func (m MonthModel) SelectedRange() (start time.Time, end time.Time, ok bool) {
+	return m.selection.rangeBounds(m.ActiveDate())
+}
+
+// SelectedDates returns the dates toggled on in a multi-date selection, in chronological order.
+func (m MonthModel) SelectedDates() []time.Time {
+	return m.selection.sortedDates()
+}
+
+// ActiveDate returns the active date. If no date is active, the zero time is returned.
+func (m MonthModel) ActiveDate() time.Time {
+	if m.activeDay == 0 {
+		return time.Time{}
+	}
+	return m.date(m.activeDay)
+}
+
+// FocusedDate returns the date whose content receives key presses. If no content has focus, the zero time is returned.
+func (m MonthModel) FocusedDate() time.Time {
+	return m.focusedDate
+}
+
+// PreviousMonth moves the calendar to the previous month.
+//
+// If a date is active, the active day is kept as close as possible to its current day of the month.
+func (m MonthModel) PreviousMonth() MonthModel {
+	return m.shiftMonths(-1)
+}
+
+// NextMonth moves the calendar to the next month.
+//
+// If a date is active, the active day is kept as close as possible to its current day of the month.
+func (m MonthModel) NextMonth() MonthModel {
+	return m.shiftMonths(1)
+}
+
+// PreviousYear moves the calendar to the same month in the previous year.
+//
+// If a date is active, the active day is kept as close as possible to its current day of the month.
+func (m MonthModel) PreviousYear() MonthModel {
+	return m.shiftMonths(-12)
+}
+
+// NextYear moves the calendar to the same month in the next year.
+//
+// If a date is active, the active day is kept as close as possible to its current day of the month.
+func (m MonthModel) NextYear() MonthModel {
+	return m.shiftMonths(12)
+}
+
+// shiftMonths moves the calendar by n months and re-anchors the active day in the new month.
+func (m MonthModel) shiftMonths(n int) MonthModel {
+	first := m.date(1).AddDate(0, n, 0)
+	m.year = first.Year()
+	m.month = first.Month()
+
+	if m.activeDay == 0 {
+		return m
+	}
+	m.activeDay = m.nearestSelectableDay(min(m.activeDay, DaysInMonth(m.year, m.month)))
+
+	return m
+}
+
+// setActiveDate sets the active day, moving the calendar to the month of the date if necessary.
+func (m MonthModel) setActiveDate(date time.Time) MonthModel {
+	m.year = date.Year()
+	m.month = date.Month()
+	m.activeDay = date.Day()
+
+	return m
+}
+
+// date returns the given day of the represented month, as midnight in the model's location.
+func (m MonthModel) date(day int) time.Time {
+	loc := m.location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(m.year, m.month, day, 0, 0, 0, 0, loc)
+}
+
+// selectable determines if a day of the month may be active.
+func (m MonthModel) selectable(day int) bool {
+	return m.bounds.selectable(m.weekdays, m.date(day))
+}
+
+// firstSelectableDay finds the first day of the month that falls on a visible weekday and is not disabled.
+//
+// If no day is selectable, zero is returned.
+func (m MonthModel) firstSelectableDay() int {
+	for d := 1; d <= DaysInMonth(m.year, m.month); d++ {
+		if m.selectable(d) {
+			return d
+		}
+	}
+	return 0
+}
 
-	inititalizeActiveDay := func() bool {
-		if m.activeDay != 0 {
-			return false
+// lastSelectableDay finds the last day of the month that falls on a visible weekday and is not disabled.
+//
+// If no day is selectable, zero is returned.
+func (m MonthModel) lastSelectableDay() int {
+	for d := DaysInMonth(m.year, m.month); d > 0; d-- {
+		if m.selectable(d) {
+			return d
 		}
-		// If uninitialized, set the active day as the first visible day in the month
-		// so that cursor works as expected
-		firstDay := time.Date(m.year, m.month, 1, 0, 0, 0, 0, time.UTC)
-		offset := (7 + (int(firstVisibleWeekday) - int(firstDay.Weekday()))) % 7
+	}
+	return 0
+}
 
-		m.activeDay = 1 + offset
+// nearestSelectableDay finds the selectable day of the month closest to the given day, preferring later days.
+//
+// If no day is selectable, zero is returned.
+func (m MonthModel) nearestSelectableDay(day int) int {
+	for d := day; d <= DaysInMonth(m.year, m.month); d++ {
+		if m.selectable(d) {
+			return d
+		}
+	}
+	for d := day - 1; d > 0; d-- {
+		if m.selectable(d) {
+			return d
+		}
+	}
+	return 0
+}
 
-		return true
+// step moves the active date in increments of step days, skipping dates that are hidden or disabled. If no
+// selectable date is found, the active date is unchanged.
+func (m MonthModel) step(step int) MonthModel {
+	if date, ok := m.bounds.step(m.weekdays, m.ActiveDate(), step); ok {
+		m = m.setActiveDate(date)
 	}
+	return m
+}
+
+// Init the MonthModel.
+func (m MonthModel) Init() tea.Cmd {
+	if m.refreshAtMidnight {
+		return midnightTick(m.id, m.clock, m.location)
+	}
+	return nil
+}
 
+// Update the MonthModel.
+func (m MonthModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
+	if msg, ok := msg.(tea.WindowSizeMsg); ok && m.fitWindow {
+		m = m.Size(msg.Width, msg.Height)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		oldActiveDay := m.activeDay
-		daysInMonth := DaysInMonth(m.year, m.month)
+		// While the content of a date has focus, it receives every key press but the one to exit it
+		var handled bool
+		var cmd tea.Cmd
+		m.focusedDate, handled, cmd = updateFocus(msg, m.keyMap, m.days, m.ActiveDate(), m.focusedDate)
+		if handled {
+			cmds = append(cmds, cmd)
+			break
+		}
+
+		oldActiveDate := m.ActiveDate()
+		oldYear, oldMonth := m.year, m.month
+
+		m.selection, cmd = m.selection.update(msg, m.keyMap, m.ActiveDate())
+		cmds = append(cmds, cmd)
+
 		switch {
 		case key.Matches(msg, m.keyMap.Left):
-			inititalizeActiveDay()
-
-			ad := time.Date(m.year, m.month, m.activeDay, 0, 0, 0, 0, time.UTC)
-			diff := (7 + (int(ad.Weekday()) - int(m.weekdays.Previous(ad)))) % 7
-			i := m.activeDay - diff
-			if i <= 0 {
-				// Find last visible day of month
-				i = daysInMonth
-				for d := time.Date(m.year, m.month, i, 0, 0, 0, 0, time.UTC); !m.weekdays.IsVisible(d.Weekday()); d = d.AddDate(0, 0, -1) {
-					i -= 1
-				}
+			// If initializing the active day, assume the intent of the left event was to wrap around
+			// to the last selectable day of the month.
+			if m.activeDay == 0 {
+				m.activeDay = m.lastSelectableDay()
+				break
 			}
-			m.activeDay = i
+			m = m.step(-1)
 		case key.Matches(msg, m.keyMap.Right):
-			// If initializing the active day, assume the intent of the right event was to move down
-			// into the first visible day of the month.
-			if inititalizeActiveDay() {
+			// If initializing the active day, assume the intent of the right event was to move
+			// into the first selectable day of the month.
+			if m.activeDay == 0 {
+				m.activeDay = m.firstSelectableDay()
 				break
 			}
-
-			ad := time.Date(m.year, m.month, m.activeDay, 0, 0, 0, 0, time.UTC)
-			diff := (7 + int(m.weekdays.Next(ad)) - int(ad.Weekday())) % 7
-			i := m.activeDay + diff
-			if i > daysInMonth {
-				i = 1
-			}
-			m.activeDay = i
+			m = m.step(1)
 		case key.Matches(msg, m.keyMap.Up):
-			inititalizeActiveDay()
-
-			// No need to calculate visiblity because we can assume that the same weekday in the week prior to the
-			// current week will also be visible
-			i := m.activeDay - 7
-			if i < 0 {
-				i = m.activeDay + 28
-				if i > daysInMonth {
-					i = i - 7
+			// If initializing the active day, assume the intent of the up event was to wrap around
+			// to the last week of the month.
+			if m.activeDay == 0 {
+				d := m.firstSelectableDay()
+				for d > 0 && d+7 <= DaysInMonth(m.year, m.month) && m.selectable(d+7) {
+					d += 7
 				}
+				m.activeDay = d
+				break
 			}
-			m.activeDay = i
+			// The same weekday is always visible in other weeks, so only disabled dates are skipped
+			m = m.step(-7)
 		case key.Matches(msg, m.keyMap.Down):
 			// If initializing the active day, assume the intent of the down event was to move down
-			// into the first visible day of the month.
-			if inititalizeActiveDay() {
+			// into the first selectable day of the month.
+			if m.activeDay == 0 {
+				m.activeDay = m.firstSelectableDay()
 				break
 			}
+			// The same weekday is always visible in other weeks, so only disabled dates are skipped
+			m = m.step(7)
+		case key.Matches(msg, m.keyMap.PreviousMonth):
+			m = m.PreviousMonth()
+		case key.Matches(msg, m.keyMap.NextMonth):
+			m = m.NextMonth()
+		case key.Matches(msg, m.keyMap.PreviousYear):
+			m = m.PreviousYear()
+		case key.Matches(msg, m.keyMap.NextYear):
+			m = m.NextYear()
+		case key.Matches(msg, m.keyMap.Today):
+			m = m.JumpToToday()
+		case (m.overflow == OverflowScroll) && key.Matches(msg, m.keyMap.ScrollUp):
+			m.scroll = max(0, m.scroll-1)
+		case (m.overflow == OverflowScroll) && key.Matches(msg, m.keyMap.ScrollDown):
+			m.scroll = min(m.scroll+1, m.maxScroll())
+		}
 
-			// No need to calculate visiblity because we can assume that the same weekday in the week following the
-			// current week will also be visible
-			i := m.activeDay + 7
-			if i > daysInMonth {
-				i = m.activeDay - 28
-				if m.activeDay < 28 {
-					i = i + 7
-				}
-			}
-			m.activeDay = i
+		// Scrolling starts over on every date
+		if !m.ActiveDate().Equal(oldActiveDate) {
+			m.scroll = 0
 		}
+		cmds = append(cmds, m.changed(oldYear, oldMonth, oldActiveDate)...)
+	case tea.MouseMsg:
+		oldActiveDate := m.ActiveDate()
+		oldYear, oldMonth := m.year, m.month
 
-		if oldActiveDay != m.activeDay {
-			cmds = append(cmds, func() tea.Msg {
-				return ActiveDateMsg{
-					Date: time.Date(m.year, m.month, m.activeDay, 0, 0, 0, 0, time.UTC),
-				}
-			})
+		var cmd tea.Cmd
+		m, cmd = m.updateMouse(msg)
+		cmds = append(cmds, cmd)
+
+		if !m.ActiveDate().Equal(oldActiveDate) {
+			m.scroll = 0
 		}
-	case DayContentMsg:
-		if msg.Date.Year() != m.year {
-			break
+		// Moving to another date leaves the focused content
+		if (m.focusedDate != (time.Time{})) && !m.ActiveDate().Equal(m.focusedDate) {
+			cmds = append(cmds, blurred(m.focusedDate))
+			m.focusedDate = time.Time{}
 		}
-		if msg.Date.Month() != m.month {
-			break
+		cmds = append(cmds, m.changed(oldYear, oldMonth, oldActiveDate)...)
+	case TodayMsg:
+		if (msg.id == m.id) && m.refreshAtMidnight {
+			cmds = append(cmds, midnightTick(m.id, m.clock, m.location))
 		}
-		// Translate from 1-indexed date to 0-indexed array
-		i := msg.Date.Day() - 1
-		m.days[i] = msg.Content
+	case DayContentMsg:
+		// Content is kept for every date so that it is still available when moving between months
+		m.days[dateIn(msg.Date, m.location)] = msg.Content
 	default:
 		for i, d := range m.days {
 			n, cmd := d.Update(msg)
@@ -203,6 +722,106 @@ func (m MonthModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// changed reports the month and the active date, if they changed from the old month and active date.
+func (m MonthModel) changed(oldYear int, oldMonth time.Month, oldActiveDate time.Time) []tea.Cmd {
+	var cmds []tea.Cmd
+	if (oldYear != m.year) || (oldMonth != m.month) {
+		year, month := m.year, m.month
+		cmds = append(cmds, func() tea.Msg {
+			return MonthChangedMsg{
+				Year:  year,
+				Month: month,
+			}
+		})
+	}
+	// Moving to a month without selectable dates clears the active date, which is not reported
+	if activeDate := m.ActiveDate(); !activeDate.Equal(oldActiveDate) && (activeDate != time.Time{}) {
+		cmds = append(cmds, func() tea.Msg {
+			return ActiveDateMsg{
+				Date: activeDate,
+			}
+		})
+	}
+	return cmds
+}
+
+// updateMouse handles mouse events within the view: clicking a date makes it active, double clicking it selects it,
+// and the scroll wheel moves between months.
+func (m MonthModel) updateMouse(msg tea.MouseMsg) (MonthModel, tea.Cmd) {
+	layout, weekStarts := m.layout()
+	x, y := msg.X-m.offsetX, msg.Y-m.offsetY
+	if !layout.contains(x, y) {
+		return m, nil
+	}
+
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		m = m.PreviousMonth()
+	case msg.Button == tea.MouseButtonWheelDown:
+		m = m.NextMonth()
+	case isLeftClick(msg):
+		date, ok := m.dateAt(layout, weekStarts, x, y)
+		if !ok || !m.selectable(date.Day()) {
+			break
+		}
+		m = m.setActiveDate(date)
+
+		var double bool
+		if m.clicks, double = m.clicks.click(date, m.clock()); double {
+			return m, func() tea.Msg {
+				return DateSelectedMsg{
+					Date: date,
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// layout measures the rendered headers and weeks, returning the layout and the first date of each week row.
+func (m MonthModel) layout() (gridLayout, []time.Time) {
+	weeks, weekStarts := m.viewWeekCells()
+
+	l := gridLayout{top: gloss.Height(m.ViewHeaders())}
+	for i, week := range weeks {
+		if m.showWeekNumbers {
+			l.left = gloss.Width(week[0])
+			week = week[1:]
+		}
+		// Every week has the same columns, including padding days
+		if i == 0 {
+			for _, day := range week {
+				l.columns = append(l.columns, gloss.Width(day))
+			}
+		}
+		l.rows = append(l.rows, gloss.Height(gloss.JoinHorizontal(gloss.Top, week...)))
+	}
+
+	return l, weekStarts
+}
+
+// dateAt finds the date of the month rendered at a position relative to the top-left corner of the view. Padding days
+// of the neighboring months are not dates of the month.
+func (m MonthModel) dateAt(layout gridLayout, weekStarts []time.Time, x int, y int) (time.Time, bool) {
+	column, row, ok := layout.cell(x, y)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	for i, n := 0, 0; i < 7; i++ {
+		date := weekStarts[row].AddDate(0, 0, i)
+		if !m.weekdays.IsVisible(date.Weekday()) {
+			continue
+		}
+		if n == column {
+			return date, (date.Year() == m.year) && (date.Month() == m.month)
+		}
+		n++
+	}
+	return time.Time{}, false
+}
+
 // View renders the MonthModel.
 func (m MonthModel) View() string {
 	return gloss.JoinVertical(
@@ -214,11 +833,16 @@ func (m MonthModel) View() string {
 
 // ViewHeaders renders the weekday headers.
 func (m MonthModel) ViewHeaders() string {
+	m = m.fit()
+
 	startDate := m.StartOfFirstWeek()
 	first := m.weekdays.First(startDate)
 	last := m.weekdays.Last(startDate)
 
 	var headers []string
+	if m.showWeekNumbers {
+		headers = append(headers, m.styles.WeekNumberHeaderStyle.Render(m.locale.WeekNumberLabel))
+	}
 	for i := 0; i < 7; i++ {
 		day := startDate.AddDate(0, 0, i)
 		label, ok := m.weekdays.Get(day.Weekday())
@@ -242,24 +866,40 @@ func (m MonthModel) ViewHeaders() string {
 
 // ViewWeeks renders the calendar main block.
 func (m MonthModel) ViewWeeks() string {
+	weeks, _ := m.viewWeekCells()
+
+	// Combine each week into a horizontal string
+	var rows []string
+	for _, week := range weeks {
+		rows = append(
+			rows,
+			gloss.JoinHorizontal(gloss.Top, week...),
+		)
+	}
+
+	// Combine individual week rows together into a vertical month
+	return gloss.JoinVertical(gloss.Top, rows...)
+}
+
+// viewWeekCells renders the cells of each week row, led by the week number if enabled, and returns them with the first
+// date of each week.
+func (m MonthModel) viewWeekCells() ([][]string, []time.Time) {
+	m = m.fit()
+
 	daysInMonth := DaysInMonth(m.year, m.month)
 	firstWeekdayOfMonth := FirstWeekdayOfMonth(m.year, m.month)
-	weeksInMonth := CalendarRowsInMonth(m.year, m.month, m.startOfWeek)
+	weeksInMonth := m.weekRows()
 
-	// If the first week starts in the previous month and the last visible day of that week is still in the previous
-	// month, remove it from the number of weeks for the purpose of rendering the calendar
 	calendarStartDate := m.StartOfFirstWeek()
-	spread := m.weekdays.Spread(calendarStartDate)
-	if calendarStartDate.AddDate(0, 0, spread).Month() < m.month {
-		weeksInMonth -= 1
-	}
-
 	firstVisibleWeekday := m.weekdays.First(calendarStartDate)
 
+	events, bars := m.dateEvents()
+
 	var weeks [][]string
 	var week []string
+	var weekStarts []time.Time
 	for i := 0; i < daysInMonth; i++ {
-		date := time.Date(m.year, m.month, (i + 1), 0, 0, 0, 0, time.UTC)
+		date := m.date(i + 1)
 		if !m.weekdays.IsVisible(date.Weekday()) {
 			continue
 		}
@@ -270,12 +910,12 @@ func (m MonthModel) ViewWeeks() string {
 			weeks = append(weeks, week)
 			week = nil
 		}
+		if len(week) == 0 {
+			weekStarts = append(weekStarts, StartOfWeekContaining(date, m.startOfWeek))
+		}
 
 		// Render day number and day body into one block of text
-		body := m.styles.DateStyles.BodyStyle.Render("")
-		if dayBodyModel, ok := m.days[i]; ok {
-			body = m.styles.DateStyles.BodyStyle.Render(dayBodyModel.View())
-		}
+		body, _ := m.viewBody(date, events[date], bars[date])
 
 		lastWeek := len(weeks) == (weeksInMonth - 1)
 		day := m.ViewDay(wd, i+1, body, lastWeek)
@@ -285,14 +925,14 @@ func (m MonthModel) ViewWeeks() string {
 	}
 
 	// Pad end of month
-	padDay := time.Date(m.year, m.month+1, 0, 0, 0, 0, 0, time.UTC)
+	padDay := m.date(DaysInMonth(m.year, m.month))
 	for len(week) < len(m.weekdays) {
 		padDay = padDay.AddDate(0, 0, 1)
 
 		if !m.weekdays.IsVisible(padDay.Weekday()) {
 			continue
 		}
-		day := m.ViewDay(padDay.Weekday(), 0, "", true)
+		day := m.ViewDay(padDay.Weekday(), 0, m.styles.DateStyles.BodyStyle.Render(""), true)
 		week = append(week, day)
 	}
 	weeks = append(weeks, week)
@@ -307,42 +947,188 @@ func (m MonthModel) ViewWeeks() string {
 		if !m.weekdays.IsVisible(padDay.Weekday()) {
 			continue
 		}
-		day := m.ViewDay(padDay.Weekday(), 0, "", false)
+		day := m.ViewDay(padDay.Weekday(), 0, m.styles.DateStyles.BodyStyle.Render(""), false)
 		pad = append(pad, day)
 	}
 	weeks[0] = append(pad, week...)
 
-	// Combine each week into a horizontal string
-	var rows []string
-	for _, week := range weeks {
-		rows = append(
-			rows,
-			gloss.JoinHorizontal(gloss.Top, week...),
+	// Lead each week with its week number
+	if m.showWeekNumbers {
+		for i, start := range weekStarts {
+			number := m.locale.WeekNumbering.rowWeek(start, m.startOfWeek)
+			weeks[i] = append([]string{m.styles.WeekNumberStyle.Render(fmt.Sprintf("%d", number))}, weeks[i]...)
+		}
+	}
+
+	return weeks, weekStarts
+}
+
+// dateEvents groups the visible events of the month by date. Multi-day events are laid out as bars across dates, which
+// are drawn above the other events of each date.
+func (m MonthModel) dateEvents() (map[time.Time][]Event, map[time.Time][]eventBarCell) {
+	daysInMonth := DaysInMonth(m.year, m.month)
+
+	var visibleDates []time.Time
+	for i := 0; i < daysInMonth; i++ {
+		if date := m.date(i + 1); m.weekdays.IsVisible(date.Weekday()) {
+			visibleDates = append(visibleDates, date)
+		}
+	}
+	var occurrences []Event
+	if m.events != nil {
+		occurrences = m.events.Events(m.date(1), m.date(1).AddDate(0, 1, 0))
+	}
+	var singleDay, multiDay []Event
+	for _, e := range occurrences {
+		if e.isMultiDay(m.date(1).Location()) {
+			multiDay = append(multiDay, e)
+		} else {
+			singleDay = append(singleDay, e)
+		}
+	}
+
+	return groupByDate(singleDay, m.date(1), daysInMonth), layoutEventBars(multiDay, visibleDates, m.startOfWeek)
+}
+
+// viewBody renders the body of a date, which is the date's content if it has any, or else its holiday label and
+// events. The maximum scroll offset of the body is returned alongside it.
+func (m MonthModel) viewBody(date time.Time, events []Event, bars []eventBarCell) (string, int) {
+	style := m.styles.DateStyles.BodyStyle
+	if content, ok := m.days[date]; ok {
+		if date.Equal(m.focusedDate) {
+			style = focusedStyle(m.styles.DateStyles, style)
+		}
+		width, height := bodySize(m.styles.DateStyles, style, 1)
+		view, maxScroll := fitContent(
+			content.View(),
+			width,
+			height,
+			m.overflow,
+			itemCount(content),
+			m.scrollOf(date),
+			m.styles.DateStyles,
 		)
+		return style.Render(view), maxScroll
 	}
+	if (len(events) > 0) || (len(bars) > 0) || (m.holidayLabel(date) != "") {
+		return m.viewEvents(date, events, bars)
+	}
+	return style.Render(""), 0
+}
 
-	// Combine individual week rows together into a vertical month
-	return gloss.JoinVertical(gloss.Top, rows...)
+// scrollOf returns the scroll offset of a date, which is zero except for the active date when scrolling is enabled.
+func (m MonthModel) scrollOf(date time.Time) int {
+	if (m.overflow == OverflowScroll) && date.Equal(m.ActiveDate()) {
+		return m.scroll
+	}
+	return 0
+}
+
+// maxScroll calculates the maximum scroll offset of the active date's body.
+func (m MonthModel) maxScroll() int {
+	if m.activeDay == 0 {
+		return 0
+	}
+
+	m = m.fit()
+	date := m.ActiveDate()
+	events, bars := m.dateEvents()
+	_, maxScroll := m.viewBody(date, events[date], bars[date])
+	return maxScroll
+}
+
+// viewEvents renders the bars of multi-day events crossing a day, the holiday label of the day, and the other events of
+// the day into the body of the day. Bars are drawn first, so that they stay on the same line across dates with and
+// without a label. The maximum scroll offset of the events is returned alongside the body.
+func (m MonthModel) viewEvents(date time.Time, events []Event, bars []eventBarCell) (string, int) {
+	style := m.styles.DateStyles.BodyStyle
+
+	// Events are already laid out to the width of the body, so they must not be re-aligned
+	width := m.styles.DateStyles.Width - style.GetHorizontalFrameSize()
+	height := m.styles.DateStyles.Height - 1 - style.GetVerticalFrameSize()
+	lines := viewEventBars(bars, width, height, m.styles.DateStyles.EventStyles)
+	if label := m.holidayLabel(date); (label != "") && (height > len(lines)) {
+		lines = append(lines, m.styles.DateStyles.HolidayLabelStyle.Render(ansi.Truncate(label, width, "…")))
+	}
+	view, maxScroll := m.viewEventList(date, events, width, height-len(lines))
+	if view != "" {
+		lines = append(lines, view)
+	}
+	return style.Align(gloss.Left).Render(strings.Join(lines, "\n")), maxScroll
+}
+
+// viewEventList renders events into the given space. Unless overflow is left to the renderer, every event is rendered
+// so that the overflow mode decides which are shown.
+func (m MonthModel) viewEventList(date time.Time, events []Event, width int, height int) (string, int) {
+	if (len(events) == 0) || (height < 1) {
+		return "", 0
+	}
+
+	renderer := m.eventRenderer
+	if renderer == nil {
+		renderer = EventListRenderer
+	}
+	if m.overflow == OverflowNone {
+		return renderer(date, events, width, height, m.styles.DateStyles.EventStyles), 0
+	}
+
+	view := renderer(date, events, width, max(height, len(events)), m.styles.DateStyles.EventStyles)
+
+	// Events only count as items when each takes one line, and otherwise the hidden lines are counted
+	items := len(events)
+	if gloss.Height(view) != items {
+		items = 0
+	}
+	return fitContent(view, width, height, m.overflow, items, m.scrollOf(date), m.styles.DateStyles)
+}
+
+// holidayLabel provides the name of the holiday on a date if holiday labels are enabled, or else an empty string.
+func (m MonthModel) holidayLabel(date time.Time) string {
+	if !m.showHolidayLabels {
+		return ""
+	}
+	h, _ := m.Holiday(date)
+	return h.Name
 }
 
 // ViewDay renders a single day.
 //
 // If zero is passed in for the day, an empty date block will be rendered.
 func (m MonthModel) ViewDay(weekday time.Weekday, day int, body string, lastRow bool) string {
+	m = m.fit()
+
 	num := m.styles.DateStyles.NumberStyle.Render("")
 	if day > 0 {
-		style := m.styles.DateStyles.NumberStyle
-		if day == m.activeDay {
-			style = m.styles.DateStyles.ActiveNumberStyle
+		date := m.date(day)
+		state := m.DateState(date)
+		style := m.selection.position(date, m.ActiveDate()).style(m.styles.DateStyles, m.styles.DateStyles.NumberStyle)
+		if state.Holiday {
+			style = m.styles.DateStyles.HolidayNumberStyle.Inherit(style)
+		}
+		if m.dateStyleFunc != nil {
+			style = m.dateStyleFunc(date, state).Inherit(style)
+		}
+		if state.Disabled {
+			style = m.styles.DateStyles.DisabledNumberStyle.Inherit(style)
+		}
+		if state.Today {
+			style = m.styles.DateStyles.TodayNumberStyle.Inherit(style)
+		}
+		if state.Active {
+			style = m.styles.DateStyles.ActiveNumberStyle.Inherit(style)
 		}
 		num = style.Render(fmt.Sprintf("%d", day))
 	}
 
-	dateBlock := gloss.JoinVertical(
-		gloss.Top,
-		num,
-		body,
-	)
+	// Dates that are only tall enough for their number omit the body
+	dateBlock := num
+	if m.styles.DateStyles.Height > 1 {
+		dateBlock = gloss.JoinVertical(
+			gloss.Top,
+			num,
+			body,
+		)
+	}
 
 	// Figure out if the border style is left, middle, right
 	// or bottom-left, bottom-middle, or bottom-right
@@ -376,22 +1162,26 @@ func (m MonthModel) ViewDay(weekday time.Weekday, day int, body string, lastRow
 
 // Title generates a title for the calendar that may be used during rendering.
 func (m MonthModel) Title(includeYear bool) string {
-	d := time.Date(m.year, m.month, 1, 0, 0, 0, 0, time.UTC)
+	d := m.date(1)
 
 	if includeYear {
-		return d.Format("January 2006")
+		return m.locale.Format(d, cmp.Or(m.locale.TitleFormat, LocaleEnUS().TitleFormat))
 	}
-	return d.Format("January")
+	return m.locale.MonthName(d.Month())
 }
 
 // StartOfFirstWeek calculates the first day of the first full week of the month.
 func (m MonthModel) StartOfFirstWeek() time.Time {
-	startDate := time.Date(m.year, m.month, 1, 0, 0, 0, 0, time.UTC)
-	diff := int(startDate.Weekday()) - int(m.startOfWeek)
+	return StartOfWeekContaining(m.date(1), m.startOfWeek)
+}
+
+// StartOfWeekContaining calculates the first day of the week that contains the date.
+func StartOfWeekContaining(date time.Time, startOfWeek time.Weekday) time.Time {
+	diff := int(date.Weekday()) - int(startOfWeek)
 	if diff < 0 {
 		diff = diff + 7
 	}
-	return startDate.AddDate(0, 0, (-1 * diff))
+	return date.AddDate(0, 0, (-1 * diff))
 }
 
 // DaysInMonth calculates the number of days in a given month and year.
@@ -399,6 +1189,23 @@ func DaysInMonth(year int, month time.Month) int {
 	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
 }
 
+// nearestVisibleDay finds the visible day of the month closest to the given day, preferring later days.
+//
+// If no day is visible, zero is returned.
+func nearestVisibleDay(weekdays Weekdays, year int, month time.Month, day int) int {
+	for d := day; d <= DaysInMonth(year, month); d++ {
+		if weekdays.IsVisible(time.Date(year, month, d, 0, 0, 0, 0, time.UTC).Weekday()) {
+			return d
+		}
+	}
+	for d := day - 1; d > 0; d-- {
+		if weekdays.IsVisible(time.Date(year, month, d, 0, 0, 0, 0, time.UTC).Weekday()) {
+			return d
+		}
+	}
+	return 0
+}
+
 // FirstWeekdayOfMonth calculates the weekday of the first day of the month.
 func FirstWeekdayOfMonth(year int, month time.Month) time.Weekday {
 	d := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
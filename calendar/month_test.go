@@ -8,11 +8,31 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/exp/golden"
 	"github.com/stretchr/testify/assert"
 )
 
+// collectMsgs runs a command and flattens any batched commands into their messages.
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, collectMsgs(c)...)
+	}
+	return msgs
+}
+
 func Test_NewMonth(t *testing.T) {
 	// Test
 	got := NewMonth(2024, time.September)
@@ -121,14 +141,15 @@ func TestMonthModel_Update(t *testing.T) {
 				tea.KeyMsg{Type: tea.KeyRight},
 				tea.KeyMsg{Type: tea.KeyUp},
 			},
-			wantActiveDay: 29,
+			wantActiveDay: 25,
 			wantMsgs: []tea.Msg{
 				ActiveDateMsg{Date: time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)},
-				ActiveDateMsg{Date: time.Date(2024, time.September, 29, 0, 0, 0, 0, time.UTC)},
+				MonthChangedMsg{Year: 2024, Month: time.August},
+				ActiveDateMsg{Date: time.Date(2024, time.August, 25, 0, 0, 0, 0, time.UTC)},
 			},
 		},
 		{
-			name: "month-wraparound-right",
+			name: "month-boundary-right",
 			model: func() MonthModel {
 				m := NewMonth(2024, time.September)
 				m.activeDay = 30
@@ -139,11 +160,12 @@ func TestMonthModel_Update(t *testing.T) {
 			},
 			wantActiveDay: 1,
 			wantMsgs: []tea.Msg{
-				ActiveDateMsg{Date: time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)},
+				MonthChangedMsg{Year: 2024, Month: time.October},
+				ActiveDateMsg{Date: time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)},
 			},
 		},
 		{
-			name: "month-wraparound-left",
+			name: "month-boundary-left",
 			model: func() MonthModel {
 				m := NewMonth(2024, time.September)
 				m.activeDay = 1
@@ -152,9 +174,131 @@ func TestMonthModel_Update(t *testing.T) {
 			msgs: []tea.Msg{
 				tea.KeyMsg{Type: tea.KeyLeft},
 			},
-			wantActiveDay: 30,
+			wantActiveDay: 31,
 			wantMsgs: []tea.Msg{
-				ActiveDateMsg{Date: time.Date(2024, time.September, 30, 0, 0, 0, 0, time.UTC)},
+				MonthChangedMsg{Year: 2024, Month: time.August},
+				ActiveDateMsg{Date: time.Date(2024, time.August, 31, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "month-boundary-up",
+			model: func() MonthModel {
+				m := NewMonth(2024, time.September)
+				m.activeDay = 3
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyUp},
+			},
+			wantActiveDay: 27,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2024, Month: time.August},
+				ActiveDateMsg{Date: time.Date(2024, time.August, 27, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "month-boundary-down",
+			model: func() MonthModel {
+				m := NewMonth(2024, time.December)
+				m.activeDay = 28
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+			},
+			wantActiveDay: 4,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2025, Month: time.January},
+				ActiveDateMsg{Date: time.Date(2025, time.January, 4, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "month-boundary-hidden-weekdays",
+			model: func() MonthModel {
+				m := NewMonth(2024, time.August).
+					Weekdays(Weekdays{
+						time.Monday:    "Mon",
+						time.Tuesday:   "Tue",
+						time.Wednesday: "Wed",
+						time.Thursday:  "Thu",
+						time.Friday:    "Fri",
+					})
+				m.activeDay = 30
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+			},
+			wantActiveDay: 2,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2024, Month: time.September},
+				ActiveDateMsg{Date: time.Date(2024, time.September, 2, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "next-month",
+			model: func() MonthModel {
+				m := NewMonth(2024, time.January)
+				m.activeDay = 31
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantActiveDay: 29,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2024, Month: time.February},
+				ActiveDateMsg{Date: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:  "previous-month-uninitialized",
+			model: NewMonth(2024, time.January),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgUp},
+			},
+			wantActiveDay: 0,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2023, Month: time.December},
+			},
+		},
+		{
+			name: "next-year",
+			model: func() MonthModel {
+				m := NewMonth(2024, time.February)
+				m.activeDay = 29
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyCtrlPgDown},
+			},
+			wantActiveDay: 28,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2025, Month: time.February},
+				ActiveDateMsg{Date: time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "previous-year-reanchor-hidden-weekday",
+			model: func() MonthModel {
+				m := NewMonth(2024, time.September).
+					Weekdays(Weekdays{
+						time.Monday:    "Mon",
+						time.Tuesday:   "Tue",
+						time.Wednesday: "Wed",
+						time.Thursday:  "Thu",
+						time.Friday:    "Fri",
+					})
+				m.activeDay = 30
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyCtrlPgUp},
+			},
+			wantActiveDay: 29,
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2023, Month: time.September},
+				ActiveDateMsg{Date: time.Date(2023, time.September, 29, 0, 0, 0, 0, time.UTC)},
 			},
 		},
 		{
@@ -268,7 +412,7 @@ func TestMonthModel_Update(t *testing.T) {
 				tea.KeyMsg{Type: tea.KeyLeft},
 				tea.KeyMsg{Type: tea.KeyRight},
 			},
-			wantActiveDay: 29,
+			wantActiveDay: 1,
 			wantMsgs: []tea.Msg{
 				ActiveDateMsg{Date: time.Date(2024, time.September, 5, 0, 0, 0, 0, time.UTC)},
 				ActiveDateMsg{Date: time.Date(2024, time.September, 12, 0, 0, 0, 0, time.UTC)},
@@ -280,11 +424,15 @@ func TestMonthModel_Update(t *testing.T) {
 				ActiveDateMsg{Date: time.Date(2024, time.September, 14, 0, 0, 0, 0, time.UTC)},
 				ActiveDateMsg{Date: time.Date(2024, time.September, 13, 0, 0, 0, 0, time.UTC)},
 				ActiveDateMsg{Date: time.Date(2024, time.September, 6, 0, 0, 0, 0, time.UTC)},
-				ActiveDateMsg{Date: time.Date(2024, time.September, 27, 0, 0, 0, 0, time.UTC)},
-				ActiveDateMsg{Date: time.Date(2024, time.September, 28, 0, 0, 0, 0, time.UTC)},
-				ActiveDateMsg{Date: time.Date(2024, time.September, 29, 0, 0, 0, 0, time.UTC)},
-				ActiveDateMsg{Date: time.Date(2024, time.September, 28, 0, 0, 0, 0, time.UTC)},
-				ActiveDateMsg{Date: time.Date(2024, time.September, 29, 0, 0, 0, 0, time.UTC)},
+				MonthChangedMsg{Year: 2024, Month: time.August},
+				ActiveDateMsg{Date: time.Date(2024, time.August, 30, 0, 0, 0, 0, time.UTC)},
+				ActiveDateMsg{Date: time.Date(2024, time.August, 31, 0, 0, 0, 0, time.UTC)},
+				MonthChangedMsg{Year: 2024, Month: time.September},
+				ActiveDateMsg{Date: time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)},
+				MonthChangedMsg{Year: 2024, Month: time.August},
+				ActiveDateMsg{Date: time.Date(2024, time.August, 31, 0, 0, 0, 0, time.UTC)},
+				MonthChangedMsg{Year: 2024, Month: time.September},
+				ActiveDateMsg{Date: time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)},
 			},
 		},
 
@@ -383,7 +531,7 @@ func TestMonthModel_Update(t *testing.T) {
 			for _, msg := range tt.msgs {
 				gotModel, gotCmd := tm.Update(msg)
 				tm = gotModel.(MonthModel)
-				gotMsgs = append(gotMsgs, gotCmd())
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
 			}
 
 			// Assertions
@@ -393,6 +541,64 @@ func TestMonthModel_Update(t *testing.T) {
 	}
 }
 
+func TestMonthModel_Update_DayContent(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September)
+
+	// Test
+	got, gotCmd := tm.Update(DayContentMsg{
+		Date:    time.Date(2024, time.October, 3, 12, 30, 0, 0, time.UTC),
+		Content: testDayModel{},
+	})
+
+	// Assertions
+	assert.Nil(t, gotCmd)
+	assert.Contains(t, got.(MonthModel).days, newDate(2024, time.October, 3))
+
+	// Test
+	got = got.(MonthModel).NextMonth()
+
+	// Assertions
+	assert.Contains(t, got.(MonthModel).days, newDate(2024, time.October, 3))
+}
+
+func TestMonthModel_Location(t *testing.T) {
+	// Setup
+	loc := mustLoadLocation("America/New_York")
+	tm := NewMonth(2024, time.September).
+		MinDate(newDate(2024, time.September, 2)).
+		Location(loc)
+
+	// Test
+	got, _ := tm.Update(DayContentMsg{
+		// 11pm in New York on September 30th is already October 1st in UTC
+		Date:    time.Date(2024, time.October, 1, 3, 0, 0, 0, time.UTC),
+		Content: testDayModel{},
+	})
+	got, gotCmd := got.(MonthModel).Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	// Assertions
+	assert.Contains(t, got.(MonthModel).days, time.Date(2024, time.September, 30, 0, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2024, time.September, 2, 0, 0, 0, 0, loc), got.(MonthModel).bounds.minDate)
+	assert.Equal(t, []tea.Msg{
+		ActiveDateMsg{Date: time.Date(2024, time.September, 2, 0, 0, 0, 0, loc)},
+	}, collectMsgs(gotCmd))
+}
+
+func TestMonthModel_Location_Today(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).
+		Clock(fixedClock(time.Date(2024, time.October, 1, 3, 0, 0, 0, time.UTC)))
+
+	// Test
+	got := tm.Location(mustLoadLocation("America/New_York"))
+
+	// Assertions
+	assert.Equal(t, time.October, tm.Today().Month())
+	assert.Equal(t, time.September, got.Today().Month())
+	assert.Equal(t, 30, got.Today().Day())
+}
+
 func TestMonthModel_View(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -544,7 +750,7 @@ func TestMonthModel_View(t *testing.T) {
 			tm := NewMonth(2024, time.September).
 				Weekdays(tt.weekdays).
 				StartOfWeek(tt.startOfWeek)
-			tm.days[4] = testDayModel{}
+			tm.days[newDate(2024, time.September, 5)] = testDayModel{}
 			_ = tm.Init()
 			tm.activeDay = 10
 
@@ -624,3 +830,30 @@ func TestMonthModel_Title(t *testing.T) {
 		})
 	}
 }
+
+func TestMonthModel_DateStyleFunc(t *testing.T) {
+	// Setup
+	gotStates := make(map[time.Time]DateState)
+	tm := NewMonth(2024, time.September).
+		Clock(fixedClock(newTime(2024, time.September, 12, 8, 0))).
+		MinDate(newDate(2024, time.September, 3)).
+		Selection(SelectionMultiple).
+		DateStyleFunc(func(date time.Time, state DateState) gloss.Style {
+			gotStates[date] = state
+			return gloss.NewStyle().Underline(true)
+		})
+	tm.activeDay = 20
+	tm.selection.dates = map[time.Time]bool{newDate(2024, time.September, 25): true}
+
+	// Test
+	_ = tm.ViewWeeks()
+
+	// Assertions
+	assert.Len(t, gotStates, 30)
+	assert.Equal(t, DateState{Past: true, Disabled: true}, gotStates[newDate(2024, time.September, 2)])
+	assert.Equal(t, DateState{Past: true}, gotStates[newDate(2024, time.September, 11)])
+	assert.Equal(t, DateState{Today: true}, gotStates[newDate(2024, time.September, 12)])
+	assert.Equal(t, DateState{Active: true}, gotStates[newDate(2024, time.September, 20)])
+	assert.Equal(t, DateState{Selected: true}, gotStates[newDate(2024, time.September, 25)])
+	assert.Equal(t, DateState{}, gotStates[newDate(2024, time.September, 30)])
+}
@@ -0,0 +1,90 @@
+package calendar
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// doubleClickInterval is the longest time between two clicks on the same date for them to count as a double click.
+const doubleClickInterval = 500 * time.Millisecond
+
+// DateSelectedMsg notifies to other models that a date was selected by double clicking it.
+type DateSelectedMsg struct {
+	// The selected date
+	Date time.Time
+}
+
+// gridLayout locates the date cells of a rendered calendar, so that mouse events may be mapped to dates.
+type gridLayout struct {
+	// left is the width of the columns before the first date column, e.g. week numbers or the time gutter
+	left int
+	// top is the height of the lines above the first row of dates, e.g. weekday headers
+	top int
+
+	// columns holds the width of each visible date column, including borders
+	columns []int
+	// rows holds the height of each row of dates, including borders
+	rows []int
+}
+
+// cell finds the column and row of the date cell at a position relative to the top-left corner of the view. If the
+// position is outside all date cells, ok is false.
+func (l gridLayout) cell(x int, y int) (column int, row int, ok bool) {
+	column, ok = locate(l.columns, x-l.left)
+	if !ok {
+		return 0, 0, false
+	}
+	row, ok = locate(l.rows, y-l.top)
+	if !ok {
+		return 0, 0, false
+	}
+	return column, row, true
+}
+
+// contains checks whether a position relative to the top-left corner of the view falls within the view.
+func (l gridLayout) contains(x int, y int) bool {
+	width, height := l.left, l.top
+	for _, w := range l.columns {
+		width += w
+	}
+	for _, h := range l.rows {
+		height += h
+	}
+	return (x >= 0) && (x < width) && (y >= 0) && (y < height)
+}
+
+// locate finds the index of the span that contains the offset, where spans are laid out end to end from zero.
+func locate(spans []int, offset int) (int, bool) {
+	if offset < 0 {
+		return 0, false
+	}
+	for i, span := range spans {
+		if offset < span {
+			return i, true
+		}
+		offset -= span
+	}
+	return 0, false
+}
+
+// clickTracker recognizes double clicks on dates.
+type clickTracker struct {
+	// date and time of the last click
+	date time.Time
+	at   time.Time
+}
+
+// click records a click on a date at a time, and reports whether it completes a double click. The click after a double
+// click starts over.
+func (c clickTracker) click(date time.Time, at time.Time) (clickTracker, bool) {
+	if c.date.Equal(date) && !at.Before(c.at) && (at.Sub(c.at) <= doubleClickInterval) {
+		return clickTracker{}, true
+	}
+	return clickTracker{date: date, at: at}, false
+}
+
+// isLeftClick checks whether a mouse message is a press of the left button.
+func isLeftClick(msg tea.MouseMsg) bool {
+	return (msg.Action == tea.MouseActionPress) && (msg.Button == tea.MouseButtonLeft)
+}
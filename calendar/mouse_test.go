@@ -0,0 +1,250 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+// click creates a left click at a position.
+func click(x int, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+}
+
+// wheel creates a scroll wheel event at a position.
+func wheel(x int, y int, button tea.MouseButton) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: button}
+}
+
+func Test_gridLayout_cell(t *testing.T) {
+	layout := gridLayout{left: 4, top: 3, columns: []int{7, 6, 6}, rows: []int{3, 3}}
+	tests := []struct {
+		name       string
+		x          int
+		y          int
+		wantColumn int
+		wantRow    int
+		wantOk     bool
+	}{
+		{name: "first-cell", x: 4, y: 3, wantColumn: 0, wantRow: 0, wantOk: true},
+		{name: "cell-border", x: 10, y: 5, wantColumn: 0, wantRow: 0, wantOk: true},
+		{name: "middle-cell", x: 11, y: 6, wantColumn: 1, wantRow: 1, wantOk: true},
+		{name: "last-cell", x: 22, y: 8, wantColumn: 2, wantRow: 1, wantOk: true},
+		{name: "left-gutter", x: 3, y: 4},
+		{name: "header", x: 5, y: 2},
+		{name: "past-right", x: 23, y: 4},
+		{name: "past-bottom", x: 5, y: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			gotColumn, gotRow, gotOk := layout.cell(tt.x, tt.y)
+
+			// Assertions
+			assert.Equal(t, tt.wantColumn, gotColumn)
+			assert.Equal(t, tt.wantRow, gotRow)
+			assert.Equal(t, tt.wantOk, gotOk)
+			if tt.wantOk {
+				assert.True(t, layout.contains(tt.x, tt.y))
+			}
+		})
+	}
+}
+
+func Test_clickTracker_click(t *testing.T) {
+	// Setup
+	date := newDate(2024, time.September, 18)
+	at := newTime(2024, time.September, 18, 9, 0)
+	var tracker clickTracker
+
+	// Test
+	tracker, gotFirst := tracker.click(date, at)
+	tracker, gotOther := tracker.click(date.AddDate(0, 0, 1), at.Add(100*time.Millisecond))
+	tracker, gotDouble := tracker.click(date.AddDate(0, 0, 1), at.Add(300*time.Millisecond))
+	tracker, gotAfterDouble := tracker.click(date.AddDate(0, 0, 1), at.Add(400*time.Millisecond))
+	_, gotSlow := tracker.click(date.AddDate(0, 0, 1), at.Add(time.Second))
+
+	// Assertions
+	assert.False(t, gotFirst)
+	assert.False(t, gotOther)
+	assert.True(t, gotDouble)
+	assert.False(t, gotAfterDouble)
+	assert.False(t, gotSlow)
+}
+
+func TestMonthModel_Update_Mouse(t *testing.T) {
+	weekdays := Weekdays{
+		time.Monday:    "Mon",
+		time.Tuesday:   "Tue",
+		time.Wednesday: "Wed",
+		time.Thursday:  "Thu",
+		time.Friday:    "Fri",
+	}
+
+	tests := []struct {
+		name      string
+		tm        MonthModel
+		msg       tea.MouseMsg
+		wantDate  time.Time
+		wantMonth time.Month
+		wantMsgs  []tea.Msg
+	}{
+		{
+			name:      "click-date",
+			tm:        NewMonth(2024, time.September),
+			msg:       click(20, 9),
+			wantDate:  newDate(2024, time.September, 18),
+			wantMonth: time.September,
+			wantMsgs:  []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 18)}},
+		},
+		{
+			name:      "click-offset",
+			tm:        NewMonth(2024, time.September).Offset(2, 1),
+			msg:       click(22, 10),
+			wantDate:  newDate(2024, time.September, 18),
+			wantMonth: time.September,
+			wantMsgs:  []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 18)}},
+		},
+		{
+			name: "click-hidden-weekdays-and-week-numbers",
+			tm: NewMonth(2024, time.September).
+				Weekdays(weekdays).
+				WeekNumbers(true),
+			msg:       click(16, 9),
+			wantDate:  newDate(2024, time.September, 17),
+			wantMonth: time.September,
+			wantMsgs:  []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 17)}},
+		},
+		{
+			name:      "click-padding",
+			tm:        NewMonth(2024, time.September),
+			msg:       click(20, 15),
+			wantMonth: time.September,
+		},
+		{
+			name:      "click-header",
+			tm:        NewMonth(2024, time.September),
+			msg:       click(20, 1),
+			wantMonth: time.September,
+		},
+		{
+			name:      "click-disabled",
+			tm:        NewMonth(2024, time.September).MaxDate(newDate(2024, time.September, 17)),
+			msg:       click(20, 9),
+			wantMonth: time.September,
+		},
+		{
+			name:      "wheel-down",
+			tm:        NewMonth(2024, time.September),
+			msg:       wheel(20, 9, tea.MouseButtonWheelDown),
+			wantMonth: time.October,
+			wantMsgs:  []tea.Msg{MonthChangedMsg{Year: 2024, Month: time.October}},
+		},
+		{
+			name:      "wheel-up",
+			tm:        NewMonth(2024, time.September),
+			msg:       wheel(0, 0, tea.MouseButtonWheelUp),
+			wantMonth: time.August,
+			wantMsgs:  []tea.Msg{MonthChangedMsg{Year: 2024, Month: time.August}},
+		},
+		{
+			name:      "wheel-outside",
+			tm:        NewMonth(2024, time.September),
+			msg:       wheel(60, 9, tea.MouseButtonWheelDown),
+			wantMonth: time.September,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got, gotCmd := tt.tm.Update(tt.msg)
+
+			// Assertions
+			assert.Equal(t, tt.wantDate, got.(MonthModel).ActiveDate())
+			assert.Equal(t, tt.wantMonth, got.(MonthModel).month)
+			assert.Equal(t, tt.wantMsgs, collectMsgs(gotCmd))
+		})
+	}
+}
+
+func TestMonthModel_Update_MouseDoubleClick(t *testing.T) {
+	// Setup
+	now := newTime(2024, time.September, 18, 9, 0)
+	tm := NewMonth(2024, time.September).Clock(fixedClock(now))
+
+	// Test
+	first, firstCmd := tm.Update(click(20, 9))
+	second, secondCmd := first.(MonthModel).Update(click(20, 10))
+
+	// Assertions
+	assert.Equal(t, []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 18)}}, collectMsgs(firstCmd))
+	assert.Equal(t, []tea.Msg{DateSelectedMsg{Date: newDate(2024, time.September, 18)}}, collectMsgs(secondCmd))
+	assert.Equal(t, newDate(2024, time.September, 18), second.(MonthModel).ActiveDate())
+}
+
+func TestWeekModel_Update_Mouse(t *testing.T) {
+	tests := []struct {
+		name      string
+		tm        WeekModel
+		msg       tea.MouseMsg
+		wantDate  time.Time
+		wantStart time.Time
+		wantMsgs  []tea.Msg
+	}{
+		{
+			name:      "click-header",
+			tm:        NewWeek(newDate(2024, time.September, 18)),
+			msg:       click(40, 2),
+			wantDate:  newDate(2024, time.September, 17),
+			wantStart: newDate(2024, time.September, 15),
+			wantMsgs:  []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 17)}},
+		},
+		{
+			name:      "click-body",
+			tm:        NewWeek(newDate(2024, time.September, 18)),
+			msg:       click(16, 10),
+			wantDate:  newDate(2024, time.September, 15),
+			wantStart: newDate(2024, time.September, 15),
+			wantMsgs:  []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 15)}},
+		},
+		{
+			name:      "click-time-grid",
+			tm:        NewWeek(newDate(2024, time.September, 18)).TimeGrid(true),
+			msg:       click(22, 20),
+			wantDate:  newDate(2024, time.September, 15),
+			wantStart: newDate(2024, time.September, 15),
+			wantMsgs:  []tea.Msg{ActiveDateMsg{Date: newDate(2024, time.September, 15)}},
+		},
+		{
+			name:      "click-time-gutter",
+			tm:        NewWeek(newDate(2024, time.September, 18)).TimeGrid(true),
+			msg:       click(2, 20),
+			wantStart: newDate(2024, time.September, 15),
+		},
+		{
+			name:      "wheel-up",
+			tm:        NewWeek(newDate(2024, time.September, 18)),
+			msg:       wheel(52, 2, tea.MouseButtonWheelUp),
+			wantStart: newDate(2024, time.September, 8),
+			wantMsgs: []tea.Msg{
+				WeekChangedMsg{Start: newDate(2024, time.September, 8), End: newDate(2024, time.September, 14)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got, gotCmd := tt.tm.Update(tt.msg)
+
+			// Assertions
+			assert.Equal(t, tt.wantDate, got.(WeekModel).activeDate)
+			assert.Equal(t, tt.wantStart, got.(WeekModel).startDate)
+			assert.Equal(t, tt.wantMsgs, collectMsgs(gotCmd))
+		})
+	}
+}
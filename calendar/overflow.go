@@ -0,0 +1,105 @@
+package calendar
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Overflow determines how the content of a date that is taller or wider than the date's body is shown.
+type Overflow int
+
+const (
+	// OverflowNone leaves content as rendered by the body style, which wraps wide lines and grows to fit tall content.
+	OverflowNone Overflow = iota
+
+	// OverflowEllipsis cuts wide lines and tall content to the body, ending each cut with an ellipsis.
+	OverflowEllipsis
+
+	// OverflowMore cuts wide lines with an ellipsis, and replaces the last line of tall content with the number of
+	// items not shown, e.g. "+3 more".
+	OverflowMore
+
+	// OverflowScroll shows tall content as OverflowMore does, except on the active date, whose content may be scrolled
+	// with the ScrollUp and ScrollDown bindings.
+	OverflowScroll
+)
+
+// ItemCounter may be implemented by the content models of dates to report the number of items they show, which drives
+// the "+N more" indicator. Content that does not implement it is counted as one item per line.
+type ItemCounter interface {
+	// ItemCount returns the number of items in the content.
+	ItemCount() int
+}
+
+// itemCount counts the items of content, if the content reports them, or else returns zero.
+func itemCount(content any) int {
+	if counter, ok := content.(ItemCounter); ok {
+		return counter.ItemCount()
+	}
+	return 0
+}
+
+// fitContent cuts content to a width and height according to the overflow mode, starting from the line at the scroll
+// offset. Items is the number of items in the content, or zero to count lines. The maximum useful scroll offset is
+// returned alongside the content.
+func fitContent(
+	content string,
+	width int,
+	height int,
+	overflow Overflow,
+	items int,
+	scroll int,
+	styles DateStyles,
+) (string, int) {
+	if (overflow == OverflowNone) || (height < 1) {
+		return content, 0
+	}
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, width, "…")
+	}
+	maxScroll := max(0, len(lines)-height)
+
+	if overflow == OverflowEllipsis {
+		if len(lines) > height {
+			lines = lines[:height]
+			lines[height-1] = ansi.Truncate(lines[height-1], width-1, "") + "…"
+		}
+		return strings.Join(lines, "\n"), maxScroll
+	}
+
+	start := max(0, min(scroll, maxScroll))
+	if items == 0 {
+		items = len(lines)
+	}
+
+	// The indicator takes the place of the last line, and counts the items below the lines shown
+	visible := lines[start:]
+	hidden := 0
+	if (len(visible) > height) || (items > len(lines)) {
+		shown := min(len(visible), height-1)
+		hidden = items - start - shown
+		if hidden <= 0 {
+			// Items spanning several lines are not counted, so the hidden lines are counted instead
+			hidden = len(visible) - shown
+		}
+		visible = visible[:shown]
+	}
+	if hidden > 0 {
+		more := fmt.Sprintf(cmp.Or(styles.MoreFormat, "+%d more"), hidden)
+		visible = append(visible, styles.MoreStyle.Render(ansi.Truncate(more, width, "…")))
+	}
+
+	return strings.Join(visible, "\n"), maxScroll
+}
+
+// bodySize calculates the width and height available for content within the body of a date, given the lines taken by
+// the date number.
+func bodySize(styles DateStyles, style gloss.Style, numberLines int) (int, int) {
+	return styles.Width - style.GetHorizontalFrameSize(), styles.Height - numberLines - style.GetVerticalFrameSize()
+}
@@ -0,0 +1,272 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+// testItemsModel is day content that shows one line per item and reports its items.
+type testItemsModel struct {
+	items []string
+}
+
+func (m testItemsModel) Init() tea.Cmd                           { return nil }
+func (m testItemsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) { return m, nil }
+func (m testItemsModel) View() string                            { return strings.Join(m.items, "\n") }
+func (m testItemsModel) ItemCount() int                          { return len(m.items) }
+
+// busyEvents creates a number of events on a date, an hour apart.
+func busyEvents(date time.Time, n int) EventList {
+	events := make(EventList, n)
+	for i := range events {
+		events[i] = Event{Title: "Shift", Start: date.Add(time.Duration(8+i) * time.Hour)}
+	}
+	return events
+}
+
+func Test_fitContent(t *testing.T) {
+	content := "one\ntwo\nthree\nfour\nfive"
+	tests := []struct {
+		name          string
+		content       string
+		width         int
+		height        int
+		overflow      Overflow
+		items         int
+		scroll        int
+		want          string
+		wantMaxScroll int
+	}{
+		{
+			name:     "none",
+			content:  content,
+			width:    3,
+			height:   2,
+			overflow: OverflowNone,
+			want:     content,
+		},
+		{
+			name:          "ellipsis",
+			content:       content,
+			width:         4,
+			height:        3,
+			overflow:      OverflowEllipsis,
+			want:          "one\ntwo\nthr…",
+			wantMaxScroll: 2,
+		},
+		{
+			name:     "ellipsis-fits",
+			content:  "one\ntwo",
+			width:    4,
+			height:   3,
+			overflow: OverflowEllipsis,
+			want:     "one\ntwo",
+		},
+		{
+			name:     "ellipsis-wide",
+			content:  "fourteen",
+			width:    5,
+			height:   1,
+			overflow: OverflowEllipsis,
+			want:     "four…",
+		},
+		{
+			name:          "more-lines",
+			content:       content,
+			width:         8,
+			height:        3,
+			overflow:      OverflowMore,
+			want:          "one\ntwo\n+3 more",
+			wantMaxScroll: 2,
+		},
+		{
+			name:     "more-items",
+			content:  "one\ntwo",
+			width:    8,
+			height:   3,
+			overflow: OverflowMore,
+			items:    6,
+			want:     "one\ntwo\n+4 more",
+		},
+		{
+			name:     "more-fits",
+			content:  "one\ntwo",
+			width:    8,
+			height:   3,
+			overflow: OverflowMore,
+			items:    2,
+			want:     "one\ntwo",
+		},
+		{
+			name:          "scroll",
+			content:       content,
+			width:         8,
+			height:        3,
+			overflow:      OverflowScroll,
+			scroll:        1,
+			want:          "two\nthree\n+2 more",
+			wantMaxScroll: 2,
+		},
+		{
+			name:          "scroll-end",
+			content:       content,
+			width:         8,
+			height:        3,
+			overflow:      OverflowScroll,
+			scroll:        5,
+			want:          "three\nfour\nfive",
+			wantMaxScroll: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got, gotMaxScroll := fitContent(
+				tt.content,
+				tt.width,
+				tt.height,
+				tt.overflow,
+				tt.items,
+				tt.scroll,
+				DefaultDateStyles(),
+			)
+
+			// Assertions
+			assert.Equal(t, tt.want, ansi.Strip(got))
+			assert.Equal(t, tt.wantMaxScroll, gotMaxScroll)
+		})
+	}
+}
+
+func TestMonthModel_View_Overflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		overflow Overflow
+		scroll   int
+	}{
+		{name: "none", overflow: OverflowNone},
+		{name: "ellipsis", overflow: OverflowEllipsis},
+		{name: "more", overflow: OverflowMore},
+		{name: "scroll", overflow: OverflowScroll, scroll: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewMonth(2024, time.September).
+				Size(60, 24).
+				Overflow(tt.overflow).
+				Events(busyEvents(newDate(2024, time.September, 18), 5))
+			tm.activeDay = 18
+			tm.scroll = tt.scroll
+			tm.days[newDate(2024, time.September, 19)] = testItemsModel{
+				items: []string{"Alice", "Bob", "Carol", "Dave", "Erin", "Frank"},
+			}
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestWeekModel_View_Overflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		overflow Overflow
+	}{
+		{name: "none", overflow: OverflowNone},
+		{name: "ellipsis", overflow: OverflowEllipsis},
+		{name: "more", overflow: OverflowMore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewWeek(newDate(2024, time.September, 18)).
+				Overflow(tt.overflow).
+				Events(busyEvents(newDate(2024, time.September, 18), 8))
+			tm.days[newDate(2024, time.September, 19)] = testItemsModel{
+				items: []string{"A very long day of meetings"},
+			}
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestMonthModel_Update_Scroll(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).
+		Size(60, 24).
+		Overflow(OverflowScroll).
+		Events(busyEvents(newDate(2024, time.September, 18), 5))
+	tm.activeDay = 18
+	down := tea.KeyMsg{Type: tea.KeyShiftDown}
+	up := tea.KeyMsg{Type: tea.KeyShiftUp}
+
+	// Test
+	var got tea.Model = tm
+	for range 5 {
+		got, _ = got.(MonthModel).Update(down)
+	}
+	gotBottom := got.(MonthModel).scroll
+	got, _ = got.(MonthModel).Update(up)
+	gotUp := got.(MonthModel).scroll
+	got, _ = got.(MonthModel).Update(tea.KeyMsg{Type: tea.KeyRight})
+	gotMoved := got.(MonthModel).scroll
+
+	// Assertions
+	assert.Equal(t, 3, gotBottom)
+	assert.Equal(t, 2, gotUp)
+	assert.Equal(t, 0, gotMoved)
+}
+
+func TestWeekModel_Update_Scroll(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.September, 18)).
+		Overflow(OverflowScroll).
+		Events(busyEvents(newDate(2024, time.September, 18), 8))
+	tm.activeDate = newDate(2024, time.September, 18)
+	down := tea.KeyMsg{Type: tea.KeyShiftDown}
+
+	// Test
+	var got tea.Model = tm
+	for range 5 {
+		got, _ = got.(WeekModel).Update(down)
+	}
+	gotBottom := got.(WeekModel).scroll
+	got, _ = got.(WeekModel).Update(click(16, 10))
+	gotClicked := got.(WeekModel).scroll
+
+	// Assertions
+	assert.Equal(t, 3, gotBottom)
+	assert.Equal(t, 0, gotClicked)
+}
+
+func TestMonthModel_Update_ScrollDisabled(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).
+		Size(60, 24).
+		Events(busyEvents(newDate(2024, time.September, 18), 5))
+	tm.activeDay = 18
+
+	// Test
+	got, _ := tm.Update(tea.KeyMsg{Type: tea.KeyShiftDown})
+
+	// Assertions
+	assert.Equal(t, 0, got.(MonthModel).scroll)
+}
@@ -0,0 +1,423 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the period at which a recurring event repeats.
+type Frequency int
+
+const (
+	FrequencyDaily Frequency = iota + 1
+	FrequencyWeekly
+	FrequencyMonthly
+	FrequencyYearly
+)
+
+// icsFrequencies are the names of frequencies in an iCalendar recurrence rule.
+var icsFrequencies = map[Frequency]string{
+	FrequencyDaily:   "DAILY",
+	FrequencyWeekly:  "WEEKLY",
+	FrequencyMonthly: "MONTHLY",
+	FrequencyYearly:  "YEARLY",
+}
+
+// ErrUnsupportedRecurrence is wrapped by the errors of ParseRecurrence for rules that are valid, but that use a
+// frequency or part that cannot be expanded, e.g. "FREQ=HOURLY" or "BYSETPOS=-1".
+var ErrUnsupportedRecurrence = errors.New("unsupported")
+
+// icsWeekdays are the names of weekdays in an iCalendar recurrence rule, indexed by time.Weekday.
+var icsWeekdays = [7]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// RecurrenceDay is a weekday on which a recurring event repeats. For monthly and yearly recurrences, N limits the
+// weekday to the Nth such weekday of the month, counting from the end of the month if N is negative; zero means every
+// such weekday. For yearly recurrences without months, N counts the weekdays of the year instead.
+type RecurrenceDay struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Recurrence describes how an event repeats, as an iCalendar (RFC 5545) recurrence rule does.
+//
+// The event's start is always the first occurrence. For yearly recurrences, ByDay and ByMonthDay select dates within
+// the months of ByMonth, or within the whole year if ByMonth is empty, as in iCalendar.
+type Recurrence struct {
+	// Frequency at which the event repeats
+	Frequency Frequency
+
+	// Interval between repetitions, in units of the frequency, e.g. 2 for every other week. Zero is treated as 1.
+	Interval int
+
+	// ByDay limits occurrences to the given weekdays. For weekly recurrences, it expands each week to those weekdays.
+	ByDay []RecurrenceDay
+
+	// ByMonthDay limits occurrences to the given days of the month, counting from the end of the month if negative.
+	// For monthly and yearly recurrences, it expands each month to those days.
+	ByMonthDay []int
+
+	// ByMonth limits occurrences to the given months. For yearly recurrences, it expands each year to those months.
+	ByMonth []time.Month
+
+	// WeekStart is the weekday on which weeks begin, which groups the weekdays of ByDay into weeks for weekly
+	// recurrences with an interval. If nil, weeks begin on Monday, as in iCalendar.
+	WeekStart *time.Weekday
+
+	// Count limits the number of occurrences, including excluded occurrences. Zero means no limit.
+	Count int
+
+	// Until is the last time an occurrence may start. The zero time means no limit.
+	Until time.Time
+
+	// Exceptions are the starts of occurrences that are excluded, as with iCalendar's EXDATE.
+	Exceptions []time.Time
+}
+
+// ParseRecurrence parses the value of an iCalendar RRULE property, e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE". The
+// FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, COUNT, UNTIL and WKST parts are supported. Frequencies below daily and
+// other parts are reported with an error wrapping ErrUnsupportedRecurrence.
+func ParseRecurrence(rule string) (Recurrence, error) {
+	var r Recurrence
+	for _, part := range strings.Split(rule, ";") {
+		name, value, _ := strings.Cut(part, "=")
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			for f, s := range icsFrequencies {
+				if strings.EqualFold(value, s) {
+					r.Frequency = f
+				}
+			}
+			if r.Frequency == 0 {
+				err = fmt.Errorf("%w frequency %q", ErrUnsupportedRecurrence, value)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+		case "UNTIL":
+			r.Until, _, err = parseICSTime(icsLine{value: value}, nil)
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				var d RecurrenceDay
+				if d, err = parseRecurrenceDay(day); err != nil {
+					break
+				}
+				r.ByDay = append(r.ByDay, d)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				var n int
+				if n, err = strconv.Atoi(day); err != nil {
+					break
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, month := range strings.Split(value, ",") {
+				var n int
+				if n, err = strconv.Atoi(month); (err != nil) || (n < 1) || (n > 12) {
+					err = fmt.Errorf("invalid month %q", month)
+					break
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(n))
+			}
+		case "WKST":
+			weekday := slices.Index(icsWeekdays[:], strings.ToUpper(value))
+			if weekday < 0 {
+				err = fmt.Errorf("invalid week start %q", value)
+				break
+			}
+			// Monday is the default, so it is left unset to keep rules that spell it out equal to those that do not
+			if wd := time.Weekday(weekday); wd != time.Monday {
+				r.WeekStart = &wd
+			}
+		default:
+			err = fmt.Errorf("%w part %q", ErrUnsupportedRecurrence, name)
+		}
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("invalid recurrence rule %q: %w", rule, err)
+		}
+	}
+	if r.Frequency == 0 {
+		return Recurrence{}, fmt.Errorf("invalid recurrence rule %q: missing FREQ", rule)
+	}
+
+	return r, nil
+}
+
+// parseRecurrenceDay parses a BYDAY value, e.g. "MO", "1MO" or "-1FR".
+func parseRecurrenceDay(value string) (RecurrenceDay, error) {
+	if len(value) < 2 {
+		return RecurrenceDay{}, fmt.Errorf("invalid weekday %q", value)
+	}
+
+	weekday := slices.Index(icsWeekdays[:], strings.ToUpper(value[len(value)-2:]))
+	if weekday < 0 {
+		return RecurrenceDay{}, fmt.Errorf("invalid weekday %q", value)
+	}
+	d := RecurrenceDay{Weekday: time.Weekday(weekday)}
+	if n := value[:len(value)-2]; n != "" {
+		var err error
+		if d.N, err = strconv.Atoi(n); err != nil {
+			return RecurrenceDay{}, fmt.Errorf("invalid weekday %q", value)
+		}
+	}
+
+	return d, nil
+}
+
+// String formats the recurrence as the value of an iCalendar RRULE property. Exceptions are not included, as they
+// are written as EXDATE properties.
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + icsFrequencies[r.Frequency]}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		var days []string
+		for _, d := range r.ByDay {
+			day := icsWeekdays[d.Weekday]
+			if d.N != 0 {
+				day = fmt.Sprintf("%d%s", d.N, day)
+			}
+			days = append(days, day)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		var days []string
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonth) > 0 {
+		var months []string
+		for _, m := range r.ByMonth {
+			months = append(months, strconv.Itoa(int(m)))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+	if (r.WeekStart != nil) && (*r.WeekStart != time.Monday) {
+		parts = append(parts, "WKST="+icsWeekdays[*r.WeekStart])
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if r.Until != (time.Time{}) {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(icsDateTimeLayout+"Z"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Occurrences expands a recurring event into its occurrences that overlap the time range from start, inclusive, to
+// end, exclusive. Each occurrence is a copy of the event without a recurrence, moved to the start of the occurrence.
+//
+// Events without a recurrence are returned as their only occurrence if they overlap the range.
+func (e Event) Occurrences(start time.Time, end time.Time) []Event {
+	if e.Recurrence == nil {
+		if e.Overlaps(start, end) {
+			return []Event{e}
+		}
+		return nil
+	}
+
+	r := *e.Recurrence
+	interval := max(r.Interval, 1)
+
+	var occurrences []Event
+	count := 0
+	add := func(c time.Time) bool {
+		if ((r.Count > 0) && (count >= r.Count)) || ((r.Until != (time.Time{})) && c.After(r.Until)) {
+			return false
+		}
+		count++
+
+		if !r.excludes(c, e.AllDay) {
+			if occurrence := e.moveTo(c); occurrence.Overlaps(start, end) {
+				occurrences = append(occurrences, occurrence)
+			}
+		}
+		return true
+	}
+
+	// The event's start is always the first occurrence, even if it does not match the rule
+	if !add(e.Start) {
+		return occurrences
+	}
+
+	// Periods are visited until one starts after the range, allowing for all-day occurrences in other locations
+	for period := 0; ; period++ {
+		periodStart, candidates := r.candidates(e.Start, period*interval)
+		if periodStart.After(end.AddDate(0, 0, 1)) {
+			break
+		}
+
+		for _, c := range candidates {
+			if !c.After(e.Start) {
+				continue
+			}
+			if !add(c) {
+				return occurrences
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// excludes determines if an occurrence starting at a time is one of the exceptions. The exceptions of all-day events
+// are compared by date.
+func (r Recurrence) excludes(start time.Time, allDay bool) bool {
+	return slices.ContainsFunc(r.Exceptions, func(t time.Time) bool {
+		if allDay {
+			return sameDateIn(t, time.UTC).Equal(sameDateIn(start, time.UTC))
+		}
+		return t.Equal(start)
+	})
+}
+
+// moveTo copies the event without its recurrence, keeping its duration but starting at another time.
+func (e Event) moveTo(start time.Time) Event {
+	occurrence := e
+	occurrence.Recurrence = nil
+	occurrence.Start = start
+	if e.AllDay {
+		if e.End.After(e.Start) {
+			days := sameDateIn(e.End, time.UTC).Sub(sameDateIn(e.Start, time.UTC)).Hours() / 24
+			occurrence.End = start.AddDate(0, 0, int(days))
+		}
+		return occurrence
+	}
+	occurrence.End = start.Add(laterTime(e.Start, e.End).Sub(e.Start))
+	return occurrence
+}
+
+// candidates calculates the start of a period and the starts of the occurrences in the period, in chronological
+// order, before the count, end and exceptions of the recurrence are applied. The period is offset from the period of
+// the first occurrence by a number of units of the frequency.
+func (r Recurrence) candidates(first time.Time, offset int) (time.Time, []time.Time) {
+	// Occurrences keep the time of day of the first occurrence, even across daylight saving changes
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, first.Hour(), first.Minute(), first.Second(), first.Nanosecond(), first.Location())
+	}
+
+	var periodStart time.Time
+	var dates []time.Time
+	switch r.Frequency {
+	case FrequencyDaily:
+		periodStart = at(first.Year(), first.Month(), first.Day()+offset)
+		dates = []time.Time{periodStart}
+	case FrequencyWeekly:
+		// Weeks begin on the week start, and are expanded to the weekdays of ByDay
+		weekStart := time.Monday
+		if r.WeekStart != nil {
+			weekStart = *r.WeekStart
+		}
+		daysIntoWeek := (int(first.Weekday()) - int(weekStart) + 7) % 7
+		periodStart = at(first.Year(), first.Month(), first.Day()-daysIntoWeek+7*offset)
+		if len(r.ByDay) == 0 {
+			dates = []time.Time{periodStart.AddDate(0, 0, daysIntoWeek)}
+			break
+		}
+		for i := 0; i < 7; i++ {
+			dates = append(dates, periodStart.AddDate(0, 0, i))
+		}
+	case FrequencyMonthly:
+		periodStart = at(first.Year(), first.Month()+time.Month(offset), 1)
+		dates = r.monthCandidates(first, periodStart)
+	case FrequencyYearly:
+		// Without months, years are expanded to every month if limited by ByDay or ByMonthDay
+		months := []time.Month{first.Month()}
+		switch {
+		case len(r.ByMonth) > 0:
+			months = slices.Sorted(slices.Values(r.ByMonth))
+		case (len(r.ByDay) > 0) || (len(r.ByMonthDay) > 0):
+			months = nil
+			for m := time.January; m <= time.December; m++ {
+				months = append(months, m)
+			}
+		}
+		periodStart = at(first.Year()+offset, months[0], 1)
+		for _, month := range slices.Compact(months) {
+			dates = append(dates, r.monthCandidates(first, at(first.Year()+offset, month, 1))...)
+		}
+	default:
+		// Without a frequency, the first occurrence is the only occurrence
+		return first.AddDate(1000, 0, 0), nil
+	}
+
+	return periodStart, slices.DeleteFunc(dates, func(d time.Time) bool { return !r.matches(d) })
+}
+
+// monthCandidates calculates the dates in a month, given as its first day, that the recurrence may expand to: every day
+// if limited by ByMonthDay or ByDay, else the day of the month of the first occurrence.
+func (r Recurrence) monthCandidates(first time.Time, month time.Time) []time.Time {
+	days := DaysInMonth(month.Year(), month.Month())
+
+	var dates []time.Time
+	switch {
+	case (len(r.ByMonthDay) > 0) || (len(r.ByDay) > 0):
+		for d := 0; d < days; d++ {
+			dates = append(dates, month.AddDate(0, 0, d))
+		}
+	case first.Day() <= days:
+		// Months without the day of the first occurrence are skipped
+		dates = append(dates, month.AddDate(0, 0, first.Day()-1))
+	}
+
+	return dates
+}
+
+// matches determines if a date satisfies the ByMonth, ByDay and ByMonthDay limits of the recurrence.
+func (r Recurrence) matches(date time.Time) bool {
+	days := DaysInMonth(date.Year(), date.Month())
+
+	if (len(r.ByMonth) > 0) && !slices.Contains(r.ByMonth, date.Month()) {
+		return false
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		ok := slices.ContainsFunc(r.ByMonthDay, func(d int) bool {
+			return (d == date.Day()) || (days+d+1 == date.Day())
+		})
+		if !ok {
+			return false
+		}
+	}
+
+	if len(r.ByDay) > 0 {
+		ok := slices.ContainsFunc(r.ByDay, func(d RecurrenceDay) bool {
+			if d.Weekday != date.Weekday() {
+				return false
+			}
+			// Ordinals only apply to monthly and yearly recurrences
+			if (d.N == 0) || (r.Frequency == FrequencyDaily) || (r.Frequency == FrequencyWeekly) {
+				return true
+			}
+			// Yearly recurrences without months count the weekdays of the year
+			if (r.Frequency == FrequencyYearly) && (len(r.ByMonth) == 0) {
+				daysInYear := time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+				if d.N > 0 {
+					return (date.YearDay()-1)/7+1 == d.N
+				}
+				return (daysInYear-date.YearDay())/7+1 == -d.N
+			}
+			if d.N > 0 {
+				return (date.Day()-1)/7+1 == d.N
+			}
+			return (days-date.Day())/7+1 == -d.N
+		})
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
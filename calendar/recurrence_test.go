@@ -0,0 +1,634 @@
+package calendar
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// weekdayPtr points to a weekday, for a recurrence's week start.
+func weekdayPtr(wd time.Weekday) *time.Weekday {
+	return &wd
+}
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		rule    string
+		want    Recurrence
+		wantErr string
+	}{
+		{
+			rule: "FREQ=DAILY",
+			want: Recurrence{Frequency: FrequencyDaily},
+		},
+		{
+			rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;WKST=MO",
+			want: Recurrence{
+				Frequency: FrequencyWeekly,
+				Interval:  2,
+				ByDay:     []RecurrenceDay{{Weekday: time.Monday}, {Weekday: time.Wednesday}},
+			},
+		},
+		{
+			rule: "FREQ=MONTHLY;BYDAY=1MO,-1FR;COUNT=6",
+			want: Recurrence{
+				Frequency: FrequencyMonthly,
+				ByDay:     []RecurrenceDay{{Weekday: time.Monday, N: 1}, {Weekday: time.Friday, N: -1}},
+				Count:     6,
+			},
+		},
+		{
+			rule: "FREQ=MONTHLY;BYMONTHDAY=1,-1;UNTIL=20241231T235959Z",
+			want: Recurrence{
+				Frequency:  FrequencyMonthly,
+				ByMonthDay: []int{1, -1},
+				Until:      time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+			},
+		},
+		{
+			rule:    "INTERVAL=2",
+			wantErr: "missing FREQ",
+		},
+		{
+			rule:    "FREQ=HOURLY",
+			wantErr: `unsupported frequency "HOURLY"`,
+		},
+		{
+			rule:    "FREQ=WEEKLY;BYDAY=XX",
+			wantErr: `invalid weekday "XX"`,
+		},
+		{
+			rule: "FREQ=WEEKLY;WKST=SU;BYDAY=MO",
+			want: Recurrence{
+				Frequency: FrequencyWeekly,
+				ByDay:     []RecurrenceDay{{Weekday: time.Monday}},
+				WeekStart: weekdayPtr(time.Sunday),
+			},
+		},
+		{
+			rule: "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			want: Recurrence{
+				Frequency: FrequencyYearly,
+				ByDay:     []RecurrenceDay{{Weekday: time.Thursday, N: 4}},
+				ByMonth:   []time.Month{time.November},
+			},
+		},
+		{
+			rule:    "FREQ=WEEKLY;WKST=XX",
+			wantErr: `invalid week start "XX"`,
+		},
+		{
+			rule:    "FREQ=YEARLY;BYMONTH=13",
+			wantErr: `invalid month "13"`,
+		},
+		{
+			rule:    "FREQ=MONTHLY;BYDAY=MO,TU;BYSETPOS=-1",
+			wantErr: `unsupported part "BYSETPOS"`,
+		},
+		{
+			rule:    "FREQ=YEARLY;BYWEEKNO=20",
+			wantErr: `unsupported part "BYWEEKNO"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			// Test
+			got, err := ParseRecurrence(tt.rule)
+
+			// Assertions
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Equal(t, strings.Contains(tt.wantErr, "unsupported"), errors.Is(err, ErrUnsupportedRecurrence))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			roundTrip, err := ParseRecurrence(got.String())
+			require.NoError(t, err)
+			assert.Equal(t, got, roundTrip)
+		})
+	}
+}
+
+func TestRecurrence_String(t *testing.T) {
+	// Setup
+	r := Recurrence{
+		Frequency:  FrequencyMonthly,
+		Interval:   2,
+		ByDay:      []RecurrenceDay{{Weekday: time.Tuesday, N: 2}, {Weekday: time.Sunday}},
+		ByMonthDay: []int{-1},
+		Count:      4,
+		Until:      time.Date(2025, time.January, 1, 1, 0, 0, 0, mustLoadLocation("Europe/Berlin")),
+	}
+
+	// Test
+	got := r.String()
+
+	// Assertions
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=2;BYDAY=2TU,SU;BYMONTHDAY=-1;COUNT=4;UNTIL=20250101T000000Z", got)
+}
+
+func TestEvent_Occurrences(t *testing.T) {
+	newYork := mustLoadLocation("America/New_York")
+
+	tests := []struct {
+		name       string
+		event      Event
+		rangeStart time.Time
+		rangeEnd   time.Time
+		want       []time.Time
+	}{
+		{
+			name: "not-recurring",
+			event: Event{
+				Start: newTime(2024, time.September, 10, 9, 0),
+			},
+			rangeStart: newDate(2024, time.September, 1),
+			rangeEnd:   newDate(2024, time.October, 1),
+			want:       []time.Time{newTime(2024, time.September, 10, 9, 0)},
+		},
+		{
+			name: "daily-interval",
+			event: Event{
+				Start:      newTime(2024, time.September, 28, 9, 0),
+				Recurrence: &Recurrence{Frequency: FrequencyDaily, Interval: 2},
+			},
+			rangeStart: newDate(2024, time.October, 1),
+			rangeEnd:   newDate(2024, time.October, 8),
+			want: []time.Time{
+				newTime(2024, time.October, 2, 9, 0),
+				newTime(2024, time.October, 4, 9, 0),
+				newTime(2024, time.October, 6, 9, 0),
+			},
+		},
+		{
+			name: "weekly",
+			event: Event{
+				Start:      newTime(2024, time.September, 4, 9, 0),
+				Recurrence: &Recurrence{Frequency: FrequencyWeekly},
+			},
+			rangeStart: newDate(2024, time.September, 1),
+			rangeEnd:   newDate(2024, time.September, 19),
+			want: []time.Time{
+				newTime(2024, time.September, 4, 9, 0),
+				newTime(2024, time.September, 11, 9, 0),
+				newTime(2024, time.September, 18, 9, 0),
+			},
+		},
+		{
+			name: "weekly-by-day-interval",
+			event: Event{
+				Start: newTime(2024, time.September, 4, 9, 0),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyWeekly,
+					Interval:  2,
+					ByDay:     []RecurrenceDay{{Weekday: time.Monday}, {Weekday: time.Wednesday}, {Weekday: time.Sunday}},
+				},
+			},
+			rangeStart: newDate(2024, time.September, 1),
+			rangeEnd:   newDate(2024, time.September, 23),
+			want: []time.Time{
+				newTime(2024, time.September, 4, 9, 0),
+				newTime(2024, time.September, 8, 9, 0),
+				newTime(2024, time.September, 16, 9, 0),
+				newTime(2024, time.September, 18, 9, 0),
+				newTime(2024, time.September, 22, 9, 0),
+			},
+		},
+		{
+			name: "weekly-by-day-interval-week-start",
+			event: Event{
+				Start: newTime(2024, time.September, 4, 9, 0),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyWeekly,
+					Interval:  2,
+					ByDay:     []RecurrenceDay{{Weekday: time.Monday}, {Weekday: time.Wednesday}, {Weekday: time.Sunday}},
+					WeekStart: weekdayPtr(time.Sunday),
+				},
+			},
+			rangeStart: newDate(2024, time.September, 1),
+			rangeEnd:   newDate(2024, time.September, 23),
+			want: []time.Time{
+				newTime(2024, time.September, 4, 9, 0),
+				newTime(2024, time.September, 15, 9, 0),
+				newTime(2024, time.September, 16, 9, 0),
+				newTime(2024, time.September, 18, 9, 0),
+			},
+		},
+		{
+			name: "monthly-skips-short-months",
+			event: Event{
+				Start:      newTime(2024, time.January, 31, 9, 0),
+				Recurrence: &Recurrence{Frequency: FrequencyMonthly},
+			},
+			rangeStart: newDate(2024, time.February, 1),
+			rangeEnd:   newDate(2024, time.June, 1),
+			want: []time.Time{
+				newTime(2024, time.March, 31, 9, 0),
+				newTime(2024, time.May, 31, 9, 0),
+			},
+		},
+		{
+			name: "monthly-by-month-day",
+			event: Event{
+				Start:      newTime(2024, time.January, 1, 9, 0),
+				Recurrence: &Recurrence{Frequency: FrequencyMonthly, ByMonthDay: []int{15, -1}},
+			},
+			rangeStart: newDate(2024, time.February, 1),
+			rangeEnd:   newDate(2024, time.April, 1),
+			want: []time.Time{
+				newTime(2024, time.February, 15, 9, 0),
+				newTime(2024, time.February, 29, 9, 0),
+				newTime(2024, time.March, 15, 9, 0),
+				newTime(2024, time.March, 31, 9, 0),
+			},
+		},
+		{
+			name: "monthly-by-day-ordinal",
+			event: Event{
+				Start: newTime(2024, time.September, 2, 9, 0),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyMonthly,
+					ByDay:     []RecurrenceDay{{Weekday: time.Monday, N: 1}, {Weekday: time.Friday, N: -1}},
+				},
+			},
+			rangeStart: newDate(2024, time.September, 1),
+			rangeEnd:   newDate(2024, time.November, 1),
+			want: []time.Time{
+				newTime(2024, time.September, 2, 9, 0),
+				newTime(2024, time.September, 27, 9, 0),
+				newTime(2024, time.October, 7, 9, 0),
+				newTime(2024, time.October, 25, 9, 0),
+			},
+		},
+		{
+			name: "yearly-leap-day",
+			event: Event{
+				Start:      newDate(2024, time.February, 29),
+				AllDay:     true,
+				Recurrence: &Recurrence{Frequency: FrequencyYearly},
+			},
+			rangeStart: newDate(2024, time.March, 1),
+			rangeEnd:   newDate(2033, time.January, 1),
+			want: []time.Time{
+				newDate(2028, time.February, 29),
+				newDate(2032, time.February, 29),
+			},
+		},
+		{
+			name: "yearly-by-day",
+			event: Event{
+				Start: newDate(2024, time.December, 23),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyYearly,
+					ByDay:     []RecurrenceDay{{Weekday: time.Monday}},
+				},
+				AllDay: true,
+			},
+			rangeStart: newDate(2024, time.December, 20),
+			rangeEnd:   newDate(2025, time.January, 14),
+			want: []time.Time{
+				newDate(2024, time.December, 23),
+				newDate(2024, time.December, 30),
+				newDate(2025, time.January, 6),
+				newDate(2025, time.January, 13),
+			},
+		},
+		{
+			name: "yearly-by-day-ordinal",
+			event: Event{
+				Start: newDate(2024, time.January, 25),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyYearly,
+					ByDay:     []RecurrenceDay{{Weekday: time.Thursday, N: 4}, {Weekday: time.Friday, N: -1}},
+				},
+				AllDay: true,
+			},
+			rangeStart: newDate(2024, time.February, 1),
+			rangeEnd:   newDate(2026, time.January, 1),
+			want: []time.Time{
+				newDate(2024, time.December, 27),
+				newDate(2025, time.January, 23),
+				newDate(2025, time.December, 26),
+			},
+		},
+		{
+			name: "yearly-by-month-day",
+			event: Event{
+				Start: newDate(2024, time.November, 1),
+				Recurrence: &Recurrence{
+					Frequency:  FrequencyYearly,
+					ByMonthDay: []int{1},
+				},
+				AllDay: true,
+			},
+			rangeStart: newDate(2024, time.November, 1),
+			rangeEnd:   newDate(2025, time.February, 1),
+			want: []time.Time{
+				newDate(2024, time.November, 1),
+				newDate(2024, time.December, 1),
+				newDate(2025, time.January, 1),
+			},
+		},
+		{
+			name: "yearly-by-month",
+			event: Event{
+				Start: newDate(2024, time.March, 15),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyYearly,
+					ByMonth:   []time.Month{time.September, time.March},
+				},
+				AllDay: true,
+			},
+			rangeStart: newDate(2024, time.March, 1),
+			rangeEnd:   newDate(2026, time.January, 1),
+			want: []time.Time{
+				newDate(2024, time.March, 15),
+				newDate(2024, time.September, 15),
+				newDate(2025, time.March, 15),
+				newDate(2025, time.September, 15),
+			},
+		},
+		{
+			name: "yearly-by-month-by-day",
+			event: Event{
+				Start: newDate(2024, time.November, 28),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyYearly,
+					ByDay:     []RecurrenceDay{{Weekday: time.Thursday, N: 4}},
+					ByMonth:   []time.Month{time.November},
+				},
+				AllDay: true,
+			},
+			rangeStart: newDate(2025, time.January, 1),
+			rangeEnd:   newDate(2027, time.January, 1),
+			want: []time.Time{
+				newDate(2025, time.November, 27),
+				newDate(2026, time.November, 26),
+			},
+		},
+		{
+			name: "count-includes-exceptions",
+			event: Event{
+				Start: newTime(2024, time.September, 2, 9, 0),
+				Recurrence: &Recurrence{
+					Frequency:  FrequencyDaily,
+					Count:      4,
+					Exceptions: []time.Time{newTime(2024, time.September, 3, 9, 0)},
+				},
+			},
+			rangeStart: newDate(2024, time.September, 1),
+			rangeEnd:   newDate(2024, time.October, 1),
+			want: []time.Time{
+				newTime(2024, time.September, 2, 9, 0),
+				newTime(2024, time.September, 4, 9, 0),
+				newTime(2024, time.September, 5, 9, 0),
+			},
+		},
+		{
+			name: "until",
+			event: Event{
+				Start: newTime(2024, time.September, 2, 9, 0),
+				Recurrence: &Recurrence{
+					Frequency: FrequencyWeekly,
+					Until:     newTime(2024, time.September, 16, 9, 0),
+				},
+			},
+			rangeStart: newDate(2024, time.September, 1),
+			rangeEnd:   newDate(2024, time.October, 1),
+			want: []time.Time{
+				newTime(2024, time.September, 2, 9, 0),
+				newTime(2024, time.September, 9, 9, 0),
+				newTime(2024, time.September, 16, 9, 0),
+			},
+		},
+		{
+			name: "all-day-exceptions-by-date",
+			event: Event{
+				Start:  newDate(2024, time.September, 2),
+				AllDay: true,
+				Recurrence: &Recurrence{
+					Frequency:  FrequencyDaily,
+					Exceptions: []time.Time{time.Date(2024, time.September, 3, 0, 0, 0, 0, newYork)},
+				},
+			},
+			rangeStart: newDate(2024, time.September, 2),
+			rangeEnd:   newDate(2024, time.September, 5),
+			want: []time.Time{
+				newDate(2024, time.September, 2),
+				newDate(2024, time.September, 4),
+			},
+		},
+		{
+			name: "daylight-saving-keeps-wall-clock",
+			event: Event{
+				Start:      time.Date(2024, time.October, 30, 9, 0, 0, 0, newYork),
+				Recurrence: &Recurrence{Frequency: FrequencyDaily},
+			},
+			rangeStart: time.Date(2024, time.November, 2, 0, 0, 0, 0, newYork),
+			rangeEnd:   time.Date(2024, time.November, 5, 0, 0, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2024, time.November, 2, 9, 0, 0, 0, newYork),
+				time.Date(2024, time.November, 3, 9, 0, 0, 0, newYork),
+				time.Date(2024, time.November, 4, 9, 0, 0, 0, newYork),
+			},
+		},
+		{
+			name: "no-matching-date",
+			event: Event{
+				Start:      newTime(2024, time.September, 2, 9, 0),
+				Recurrence: &Recurrence{Frequency: FrequencyYearly, ByMonthDay: []int{31}},
+			},
+			rangeStart: newDate(2025, time.September, 1),
+			rangeEnd:   newDate(2025, time.October, 1),
+			want:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := tt.event.Occurrences(tt.rangeStart, tt.rangeEnd)
+
+			// Assertions
+			var gotStarts []time.Time
+			for _, o := range got {
+				assert.Nil(t, o.Recurrence)
+				gotStarts = append(gotStarts, o.Start)
+			}
+			assert.Equal(t, tt.want, gotStarts)
+		})
+	}
+}
+
+func TestEvent_Occurrences_KeepsDuration(t *testing.T) {
+	// Setup
+	e := Event{
+		Title:      "Offsite",
+		Start:      newDate(2024, time.September, 16),
+		End:        newDate(2024, time.September, 18),
+		AllDay:     true,
+		Recurrence: &Recurrence{Frequency: FrequencyMonthly},
+	}
+
+	// Test
+	got := e.Occurrences(newDate(2024, time.October, 1), newDate(2024, time.November, 1))
+
+	// Assertions
+	require.Len(t, got, 1)
+	assert.Equal(t, newDate(2024, time.October, 16), got[0].Start)
+	assert.Equal(t, newDate(2024, time.October, 18), got[0].End)
+	assert.Equal(t, "Offsite", got[0].Title)
+}
+
+func TestParseICS_Recurring(t *testing.T) {
+	// Setup
+	berlin := mustLoadLocation("Europe/Berlin")
+
+	// Test
+	got := mustParseICSFile(t, "recurring.ics")
+
+	// Assertions
+	require.Len(t, got, 3)
+	assert.Equal(t, &Recurrence{
+		Frequency: FrequencyWeekly,
+		ByDay:     []RecurrenceDay{{Weekday: time.Monday}, {Weekday: time.Wednesday}, {Weekday: time.Friday}},
+		Exceptions: []time.Time{
+			time.Date(2024, time.September, 11, 9, 30, 0, 0, berlin),
+			time.Date(2024, time.September, 13, 9, 30, 0, 0, berlin),
+		},
+	}, got[0].Recurrence)
+	assert.Equal(t, &Recurrence{
+		Frequency: FrequencyMonthly,
+		ByDay:     []RecurrenceDay{{Weekday: time.Friday, N: -1}},
+		Count:     3,
+	}, got[1].Recurrence)
+	assert.Equal(t, &Recurrence{
+		Frequency:  FrequencyMonthly,
+		ByMonthDay: []int{-1},
+		Until:      newDate(2024, time.December, 31),
+		Exceptions: []time.Time{newDate(2024, time.November, 30)},
+	}, got[2].Recurrence)
+
+	var paydays []time.Time
+	for _, e := range got[2:].Events(newDate(2024, time.January, 1), newDate(2026, time.January, 1)) {
+		paydays = append(paydays, e.Start)
+	}
+	assert.Equal(t, []time.Time{
+		newDate(2024, time.August, 30),
+		newDate(2024, time.August, 31),
+		newDate(2024, time.September, 30),
+		newDate(2024, time.October, 31),
+		newDate(2024, time.December, 31),
+	}, paydays)
+}
+
+func TestParseICS_RecurrenceParts(t *testing.T) {
+	// Test
+	got := mustParseICSFile(t, "recurrence-parts.ics")
+
+	// Assertions
+	require.Len(t, got, 2)
+	assert.Equal(t, &Recurrence{
+		Frequency: FrequencyWeekly,
+		ByDay:     []RecurrenceDay{{Weekday: time.Monday}},
+		WeekStart: weekdayPtr(time.Sunday),
+	}, got[0].Recurrence)
+	assert.Nil(t, got[1].Recurrence)
+
+	var starts []time.Time
+	for _, e := range got.Events(newDate(2024, time.September, 1), newDate(2024, time.October, 1)) {
+		starts = append(starts, e.Start)
+	}
+	assert.Equal(t, []time.Time{
+		newTime(2024, time.September, 2, 9, 0),
+		newTime(2024, time.September, 9, 9, 0),
+		newTime(2024, time.September, 16, 9, 0),
+		newTime(2024, time.September, 23, 9, 0),
+		newTime(2024, time.September, 30, 9, 0),
+		newTime(2024, time.September, 27, 14, 0),
+	}, starts)
+}
+
+func TestWriteICS_Recurring_RoundTrip(t *testing.T) {
+	// Setup
+	events := mustParseICSFile(t, "recurring.ics")
+	var b strings.Builder
+
+	// Test
+	err := WriteICS(&b, events, time.Now())
+	require.NoError(t, err)
+	got, err := ParseICS(strings.NewReader(b.String()))
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Contains(t, b.String(), "RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR\r\n")
+	assert.Contains(t, b.String(), "EXDATE;VALUE=DATE:20241130\r\n")
+	for i := range events {
+		assert.Equal(t, events[i].Recurrence, got[i].Recurrence)
+	}
+}
+
+func TestMonthModel_VisibleEvents_Recurring_RoundTrip(t *testing.T) {
+	// Setup
+	events := mustParseICSFile(t, "recurring.ics")
+	tm := NewMonth(2024, time.September).
+		Location(mustLoadLocation("Europe/Berlin")).
+		Events(events)
+	var b strings.Builder
+
+	// Test
+	visible := tm.VisibleEvents()
+	err := WriteICS(&b, visible, time.Now())
+	require.NoError(t, err)
+	got, err := ParseICS(strings.NewReader(b.String()))
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, []Event(events), visible)
+	assert.Equal(t, 1, strings.Count(b.String(), "UID:standup@example.com\r\n"))
+	want := slices.Clone(events)
+	// Single-day events are written with an explicit end
+	want[2].End = newDate(2024, time.August, 31)
+	assert.Equal(t, want, got)
+}
+
+func TestWeekModel_VisibleEvents_Recurring(t *testing.T) {
+	// Setup
+	events := mustParseICSFile(t, "recurring.ics")
+	tm := NewWeek(newDate(2024, time.September, 18)).
+		Location(mustLoadLocation("Europe/Berlin")).
+		Events(events)
+
+	// Test
+	got := tm.VisibleEvents()
+
+	// Assertions
+	assert.Equal(t, []Event{events[0]}, got)
+}
+
+func TestMonthModel_View_Recurring(t *testing.T) {
+	// Setup
+	styles := DefaultMonthStyles()
+	styles.DateStyles.Width = 12
+	styles.DateStyles.Height = 3
+	styles.DateStyles.BodyStyle = styles.DateStyles.BodyStyle.Width(12).Height(2)
+	tm := NewMonth(2024, time.October).
+		Location(mustLoadLocation("Europe/Berlin")).
+		Styles(styles).
+		Events(mustParseICSFile(t, "recurring.ics"))
+
+	// Test
+	got := ansi.Strip(tm.ViewWeeks())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
@@ -0,0 +1,198 @@
+package calendar
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+)
+
+// SelectionMode determines how dates may be selected in a calendar.
+type SelectionMode int
+
+const (
+	// SelectionNone disables date selection.
+	SelectionNone SelectionMode = iota
+
+	// SelectionRange enables the selection of a contiguous range of dates. The range is started by anchoring the
+	// active date, and extends to the active date as the cursor moves.
+	SelectionRange
+
+	// SelectionMultiple enables toggling any number of individual dates on and off.
+	SelectionMultiple
+)
+
+// DateRangeSelectedMsg notifies to other models that a range of dates was selected.
+type DateRangeSelectedMsg struct {
+	// First date of the range
+	Start time.Time
+
+	// Last date of the range, which may be the same as the first date
+	End time.Time
+}
+
+// SelectedDatesMsg notifies to other models which dates are selected.
+type SelectedDatesMsg struct {
+	// Selected dates, in chronological order
+	Dates []time.Time
+}
+
+// selectionPosition is the position of a date within a selection.
+type selectionPosition int
+
+const (
+	notSelected selectionPosition = iota
+	selectedStart
+	selectedMiddle
+	selectedEnd
+	selectedDate
+)
+
+// selection tracks the dates selected in a calendar.
+type selection struct {
+	// mode of selection
+	mode SelectionMode
+
+	// anchor is the fixed end of a range, or the zero time if no range is in progress
+	anchor time.Time
+
+	// dates toggled on; the set is copied when modified so that models remain values
+	dates map[time.Time]bool
+}
+
+// in re-expresses the anchor and toggled dates in another location, keeping their calendar dates.
+func (s selection) in(loc *time.Location) selection {
+	s.anchor = sameDateIn(s.anchor, loc)
+	if s.dates != nil {
+		dates := make(map[time.Time]bool, len(s.dates))
+		for date := range s.dates {
+			dates[sameDateIn(date, loc)] = true
+		}
+		s.dates = dates
+	}
+	return s
+}
+
+// sortedDates returns the toggled dates in chronological order.
+func (s selection) sortedDates() []time.Time {
+	return slices.SortedFunc(maps.Keys(s.dates), func(a, b time.Time) int { return a.Compare(b) })
+}
+
+// rangeBounds calculates the ordered bounds of the range between the anchor and the active date.
+func (s selection) rangeBounds(active time.Time) (start time.Time, end time.Time, ok bool) {
+	if (s.mode != SelectionRange) || (s.anchor == (time.Time{})) || (active == (time.Time{})) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	active = normalizeDate(active)
+	if active.Before(s.anchor) {
+		return active, s.anchor, true
+	}
+	return s.anchor, active, true
+}
+
+// position determines where a date falls within the selection.
+func (s selection) position(date time.Time, active time.Time) selectionPosition {
+	if s.mode == SelectionMultiple {
+		if s.dates[normalizeDate(date)] {
+			return selectedDate
+		}
+		return notSelected
+	}
+
+	start, end, ok := s.rangeBounds(active)
+	if !ok {
+		return notSelected
+	}
+
+	date = normalizeDate(date)
+	switch {
+	case date.Equal(start):
+		return selectedStart
+	case date.Equal(end):
+		return selectedEnd
+	case date.After(start) && date.Before(end):
+		return selectedMiddle
+	}
+	return notSelected
+}
+
+// update handles the selection key bindings for the active date.
+func (s selection) update(msg tea.KeyMsg, keyMap KeyMap, active time.Time) (selection, tea.Cmd) {
+	if (s.mode == SelectionNone) || (active == (time.Time{})) {
+		return s, nil
+	}
+
+	active = normalizeDate(active)
+
+	if s.mode == SelectionMultiple {
+		if !key.Matches(msg, keyMap.Toggle) {
+			return s, nil
+		}
+
+		dates := maps.Clone(s.dates)
+		if dates == nil {
+			dates = make(map[time.Time]bool)
+		}
+		if dates[active] {
+			delete(dates, active)
+		} else {
+			dates[active] = true
+		}
+		s.dates = dates
+
+		selected := s.sortedDates()
+		return s, func() tea.Msg {
+			return SelectedDatesMsg{
+				Dates: selected,
+			}
+		}
+	}
+
+	switch {
+	case key.Matches(msg, keyMap.Anchor):
+		// Anchoring the anchor date again abandons the range
+		if s.anchor.Equal(active) {
+			s.anchor = time.Time{}
+			break
+		}
+		s.anchor = active
+	case key.Matches(msg, keyMap.Cancel):
+		s.anchor = time.Time{}
+	case key.Matches(msg, keyMap.Select):
+		// Without an anchor, the active date is selected as a single-day range
+		start, end, ok := s.rangeBounds(active)
+		if !ok {
+			start, end = active, active
+		}
+		s.anchor = time.Time{}
+
+		return s, func() tea.Msg {
+			return DateRangeSelectedMsg{
+				Start: start,
+				End:   end,
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// style selects the style for a date's position within the selection, falling back to the provided style for dates
+// that are not selected.
+func (p selectionPosition) style(styles DateStyles, fallback gloss.Style) gloss.Style {
+	switch p {
+	case selectedStart:
+		return styles.SelectedStartStyle
+	case selectedMiddle:
+		return styles.SelectedMiddleStyle
+	case selectedEnd:
+		return styles.SelectedEndStyle
+	case selectedDate:
+		return styles.SelectedDateStyle
+	}
+	return fallback
+}
@@ -0,0 +1,266 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_selection_position(t *testing.T) {
+	// Setup
+	s := selection{mode: SelectionRange, anchor: newDate(2024, time.September, 12)}
+	active := newDate(2024, time.September, 10)
+
+	tests := []struct {
+		date time.Time
+		want selectionPosition
+	}{
+		{date: newDate(2024, time.September, 9), want: notSelected},
+		{date: newDate(2024, time.September, 10), want: selectedStart},
+		{date: newDate(2024, time.September, 11), want: selectedMiddle},
+		{date: newDate(2024, time.September, 12), want: selectedEnd},
+		{date: newDate(2024, time.September, 13), want: notSelected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.Format(time.DateOnly), func(t *testing.T) {
+			// Test
+			got := s.position(tt.date, active)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMonthModel_Update_SelectionRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       SelectionMode
+		activeDay  int
+		msgs       []tea.Msg
+		wantAnchor time.Time
+		wantMsgs   []tea.Msg
+	}{
+		{
+			name:      "disabled",
+			mode:      SelectionNone,
+			activeDay: 10,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantAnchor: time.Time{},
+			wantMsgs:   nil,
+		},
+		{
+			name:      "anchor",
+			mode:      SelectionRange,
+			activeDay: 10,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+			},
+			wantAnchor: newDate(2024, time.September, 10),
+			wantMsgs:   nil,
+		},
+		{
+			name:      "anchor-again",
+			mode:      SelectionRange,
+			activeDay: 10,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+			},
+			wantAnchor: time.Time{},
+			wantMsgs:   nil,
+		},
+		{
+			name:      "cancel",
+			mode:      SelectionRange,
+			activeDay: 10,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+				tea.KeyMsg{Type: tea.KeyRight},
+				tea.KeyMsg{Type: tea.KeyEsc},
+			},
+			wantAnchor: time.Time{},
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 11)},
+			},
+		},
+		{
+			name:      "select-single",
+			mode:      SelectionRange,
+			activeDay: 10,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantAnchor: time.Time{},
+			wantMsgs: []tea.Msg{
+				DateRangeSelectedMsg{Start: newDate(2024, time.September, 10), End: newDate(2024, time.September, 10)},
+			},
+		},
+		{
+			name:      "select-backward",
+			mode:      SelectionRange,
+			activeDay: 10,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+				tea.KeyMsg{Type: tea.KeyUp},
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantAnchor: time.Time{},
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 3)},
+				DateRangeSelectedMsg{Start: newDate(2024, time.September, 3), End: newDate(2024, time.September, 10)},
+			},
+		},
+		{
+			name:      "select-across-months",
+			mode:      SelectionRange,
+			activeDay: 28,
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+				tea.KeyMsg{Type: tea.KeyDown},
+				tea.KeyMsg{Type: tea.KeyEnter},
+			},
+			wantAnchor: time.Time{},
+			wantMsgs: []tea.Msg{
+				MonthChangedMsg{Year: 2024, Month: time.October},
+				ActiveDateMsg{Date: newDate(2024, time.October, 5)},
+				DateRangeSelectedMsg{Start: newDate(2024, time.September, 28), End: newDate(2024, time.October, 5)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewMonth(2024, time.September).Selection(tt.mode)
+			tm.activeDay = tt.activeDay
+			_ = tm.Init()
+
+			// Test
+			var gotMsgs []tea.Msg
+			for _, msg := range tt.msgs {
+				gotModel, gotCmd := tm.Update(msg)
+				tm = gotModel.(MonthModel)
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+			}
+
+			// Assertions
+			assert.Equal(t, tt.wantAnchor, tm.selection.anchor)
+			assert.Equal(t, tt.wantMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestMonthModel_SelectedRange(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).Selection(SelectionRange)
+	tm.activeDay = 10
+
+	// Test
+	_, _, gotOk := tm.SelectedRange()
+
+	// Assertions
+	assert.False(t, gotOk)
+
+	// Test
+	got, _ := tm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	got, _ = got.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	gotStart, gotEnd, gotOk := got.(MonthModel).SelectedRange()
+
+	// Assertions
+	assert.True(t, gotOk)
+	assert.Equal(t, newDate(2024, time.September, 9), gotStart)
+	assert.Equal(t, newDate(2024, time.September, 10), gotEnd)
+}
+
+func TestWeekModel_Update_SelectionRange(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.September, 24)).Selection(SelectionRange)
+	tm.activeDate = newDate(2024, time.September, 27)
+
+	msgs := []tea.Msg{
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")},
+		tea.KeyMsg{Type: tea.KeyPgDown},
+		tea.KeyMsg{Type: tea.KeyEnter},
+	}
+
+	// Test
+	var gotMsgs []tea.Msg
+	for _, msg := range msgs {
+		gotModel, gotCmd := tm.Update(msg)
+		tm = gotModel.(WeekModel)
+		gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+	}
+
+	// Assertions
+	assert.Equal(t, []tea.Msg{
+		WeekChangedMsg{Start: newDate(2024, time.September, 29), End: newDate(2024, time.October, 5)},
+		ActiveDateMsg{Date: newDate(2024, time.October, 4)},
+		DateRangeSelectedMsg{Start: newDate(2024, time.September, 27), End: newDate(2024, time.October, 4)},
+	}, gotMsgs)
+}
+
+func TestMonthModel_Update_SelectionMultiple(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).Selection(SelectionMultiple)
+	tm.activeDay = 30
+
+	msgs := []tea.Msg{
+		tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")},
+		tea.KeyMsg{Type: tea.KeyUp},
+		tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")},
+		tea.KeyMsg{Type: tea.KeyPgDown},
+		tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")},
+		tea.KeyMsg{Type: tea.KeyPgUp},
+		tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")},
+	}
+
+	// Test
+	var gotMsgs []tea.Msg
+	for _, msg := range msgs {
+		gotModel, gotCmd := tm.Update(msg)
+		tm = gotModel.(MonthModel)
+		for _, m := range collectMsgs(gotCmd) {
+			if _, ok := m.(SelectedDatesMsg); ok {
+				gotMsgs = append(gotMsgs, m)
+			}
+		}
+	}
+
+	// Assertions
+	assert.Equal(t, []tea.Msg{
+		SelectedDatesMsg{Dates: []time.Time{newDate(2024, time.September, 30)}},
+		SelectedDatesMsg{Dates: []time.Time{newDate(2024, time.September, 23), newDate(2024, time.September, 30)}},
+		SelectedDatesMsg{Dates: []time.Time{
+			newDate(2024, time.September, 23),
+			newDate(2024, time.September, 30),
+			newDate(2024, time.October, 23),
+		}},
+		SelectedDatesMsg{Dates: []time.Time{newDate(2024, time.September, 30), newDate(2024, time.October, 23)}},
+	}, gotMsgs)
+	assert.Equal(t, []time.Time{newDate(2024, time.September, 30), newDate(2024, time.October, 23)}, tm.SelectedDates())
+}
+
+func TestWeekModel_Update_SelectionMultiple(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.September, 24)).Selection(SelectionMultiple)
+	tm.activeDate = newDate(2024, time.September, 24)
+
+	// Test
+	got, _ := tm.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	original := got.(WeekModel)
+	got, _ = original.Update(tea.KeyMsg{Type: tea.KeyRight})
+	got, gotCmd := got.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+
+	// Assertions
+	assert.Equal(t, []tea.Msg{
+		SelectedDatesMsg{Dates: []time.Time{newDate(2024, time.September, 24), newDate(2024, time.September, 25)}},
+	}, collectMsgs(gotCmd))
+	assert.Equal(t, []time.Time{newDate(2024, time.September, 24)}, original.SelectedDates())
+}
@@ -0,0 +1,90 @@
+package calendar
+
+import (
+	"time"
+
+	gloss "github.com/charmbracelet/lipgloss"
+)
+
+// numberStyles returns the date number styles, for adjusting them together.
+func (s *DateStyles) numberStyles() []*gloss.Style {
+	return []*gloss.Style{
+		&s.NumberStyle,
+		&s.ActiveNumberStyle,
+		&s.SelectedDateStyle,
+		&s.DisabledNumberStyle,
+		&s.TodayNumberStyle,
+		&s.HolidayNumberStyle,
+		&s.SelectedStartStyle,
+		&s.SelectedMiddleStyle,
+		&s.SelectedEndStyle,
+	}
+}
+
+// resized sets the width and height of the date block. Number and body styles with a fixed size are resized to match.
+func (s DateStyles) resized(width int, height int) DateStyles {
+	s.Width = max(1, width)
+	s.Height = max(1, height)
+
+	for _, style := range s.numberStyles() {
+		if style.GetWidth() > 0 {
+			*style = style.Width(s.Width)
+		}
+	}
+	if s.BodyStyle.GetWidth() > 0 {
+		s.BodyStyle = s.BodyStyle.Width(s.Width)
+	}
+	if s.BodyStyle.GetHeight() > 0 {
+		s.BodyStyle = s.BodyStyle.Height(max(0, s.Height-1))
+	}
+	return s
+}
+
+// borderless removes the border and padding of a style, for the compact layout.
+func borderless(style gloss.Style) gloss.Style {
+	return style.
+		UnsetBorderStyle().
+		UnsetBorderTop().
+		UnsetBorderRight().
+		UnsetBorderBottom().
+		UnsetBorderLeft().
+		UnsetPadding()
+}
+
+// shortWeekdays replaces the labels of the visible weekdays with the locale's short labels, or with the first letter of
+// each label if the locale has none. Hidden weekdays stay hidden.
+func shortWeekdays(weekdays Weekdays, locale Locale) Weekdays {
+	short := make(Weekdays, len(weekdays))
+	for wd, label := range weekdays {
+		if s, ok := locale.ShortWeekdays.Get(wd); ok {
+			short[wd] = s
+			continue
+		}
+		if r := []rune(label); len(r) > 0 {
+			short[wd] = string(r[:1])
+		}
+	}
+	return short
+}
+
+// maxLabelWidth measures the widest label of the visible weekdays.
+func maxLabelWidth(weekdays Weekdays) int {
+	width := 0
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if label, ok := weekdays.Get(wd); ok {
+			width = max(width, gloss.Width(label))
+		}
+	}
+	return width
+}
+
+// visibleWeekdays counts the visible weekdays, which are the columns of a calendar.
+func visibleWeekdays(weekdays Weekdays) int {
+	n := 0
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if weekdays.IsVisible(wd) {
+			n++
+		}
+	}
+	return max(1, n)
+}
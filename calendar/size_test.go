@@ -0,0 +1,161 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_shortWeekdays(t *testing.T) {
+	// Setup
+	weekdays := Weekdays{
+		time.Monday:  "Mon",
+		time.Tuesday: "Tue",
+	}
+
+	// Test
+	gotLocale := shortWeekdays(weekdays, LocaleEnUS())
+	gotFallback := shortWeekdays(weekdays, Locale{})
+
+	// Assertions
+	assert.Equal(t, Weekdays{time.Monday: "M", time.Tuesday: "T"}, gotLocale)
+	assert.Equal(t, Weekdays{time.Monday: "M", time.Tuesday: "T"}, gotFallback)
+}
+
+func TestDateStyles_resized(t *testing.T) {
+	// Setup
+	styles := DefaultDateStyles()
+	styles.HolidayLabelStyle = styles.HolidayLabelStyle.Width(0)
+
+	// Test
+	got := styles.resized(8, 4)
+
+	// Assertions
+	assert.Equal(t, 8, got.Width)
+	assert.Equal(t, 4, got.Height)
+	assert.Equal(t, 8, got.NumberStyle.GetWidth())
+	assert.Equal(t, 8, got.ActiveNumberStyle.GetWidth())
+	assert.Equal(t, 8, got.BodyStyle.GetWidth())
+	assert.Equal(t, 3, got.BodyStyle.GetHeight())
+	assert.Equal(t, 0, got.HolidayLabelStyle.GetWidth())
+}
+
+func TestMonthModel_View_Size(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  int
+		height int
+	}{
+		{name: "bordered", width: 60, height: 24},
+		{name: "short-labels", width: 40, height: 20},
+		{name: "compact", width: 22, height: 8},
+		{name: "width-only", width: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewMonth(2024, time.September).
+				Size(tt.width, tt.height).
+				Events(EventList{
+					{Title: "Standup", Start: newTime(2024, time.September, 18, 9, 0)},
+				})
+			tm.activeDay = 18
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			assert.LessOrEqual(t, gloss.Width(got), tt.width)
+			if tt.height > 0 {
+				assert.LessOrEqual(t, gloss.Height(got), tt.height)
+			}
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestWeekModel_View_Size(t *testing.T) {
+	tests := []struct {
+		name   string
+		tm     WeekModel
+		width  int
+		height int
+	}{
+		{
+			name:   "bordered",
+			tm:     NewWeek(newDate(2024, time.September, 18)),
+			width:  90,
+			height: 12,
+		},
+		{
+			name:   "compact",
+			tm:     NewWeek(newDate(2024, time.September, 18)).WeekNumbers(true),
+			width:  34,
+			height: 6,
+		},
+		{
+			name:  "time-grid",
+			tm:    NewWeek(newDate(2024, time.September, 18)).TimeGrid(true).TimeRange(9*time.Hour, 11*time.Hour),
+			width: 60,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := tt.tm.Size(tt.width, tt.height)
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			assert.LessOrEqual(t, gloss.Width(got), tt.width)
+			if tt.height > 0 {
+				assert.LessOrEqual(t, gloss.Height(got), tt.height)
+			}
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestMonthModel_Update_FitWindow(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September)
+
+	// Test
+	gotIgnored, _ := tm.Update(tea.WindowSizeMsg{Width: 22, Height: 8})
+	gotFitted, _ := tm.FitWindow(true).Update(tea.WindowSizeMsg{Width: 22, Height: 8})
+
+	// Assertions
+	assert.Equal(t, tm.View(), gotIgnored.(MonthModel).View())
+	assert.Equal(t, tm.Size(22, 8).View(), gotFitted.(MonthModel).View())
+}
+
+func TestWeekModel_Update_FitWindow(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.September, 18))
+
+	// Test
+	got, _ := tm.FitWindow(true).Update(tea.WindowSizeMsg{Width: 50, Height: 10})
+
+	// Assertions
+	assert.Equal(t, 50, got.(WeekModel).width)
+	assert.Equal(t, 10, got.(WeekModel).height)
+}
+
+func TestMonthModel_Update_MouseCompact(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).Size(22, 8)
+
+	// Test
+	got, _ := tm.Update(click(10, 3))
+
+	// Assertions
+	assert.Equal(t, newDate(2024, time.September, 18), got.(MonthModel).ActiveDate())
+}
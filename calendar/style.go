@@ -1,6 +1,8 @@
 package calendar
 
 import (
+	"time"
+
 	gloss "github.com/charmbracelet/lipgloss"
 )
 
@@ -16,11 +18,63 @@ type DateStyles struct {
 	NumberStyle gloss.Style
 
 	ActiveNumberStyle gloss.Style
+	// Number style for dates toggled on in a multi-date selection
+	SelectedDateStyle gloss.Style
+	// Number style for dates that may not be active
+	DisabledNumberStyle gloss.Style
+	// Number style for today's date
+	TodayNumberStyle gloss.Style
+	// Number style for holidays
+	HolidayNumberStyle gloss.Style
+	// Holiday name, shown at the top of the body when holiday labels are enabled
+	HolidayLabelStyle gloss.Style
+
+	// Selected date number styles, for the first, inner, and last dates of a selected range
+	SelectedStartStyle  gloss.Style
+	SelectedMiddleStyle gloss.Style
+	SelectedEndStyle    gloss.Style
 
 	// Contents style
 	BodyStyle gloss.Style
+	// Style applied over the body of the date whose content has focus. Padding is taken from the body style.
+	FocusedStyle gloss.Style
+
+	// Events rendered in the body, for dates without content
+	EventStyles EventStyles
+
+	// Indicator of content not shown, when the overflow mode counts it, with a layout as understood by fmt.Sprintf. If
+	// the layout is empty, "+%d more" is used.
+	MoreStyle  gloss.Style
+	MoreFormat string
 }
 
+// DateState describes a date as it is rendered, for styling by a DateStyleFunc.
+type DateState struct {
+	// Active marks the active date
+	Active bool
+
+	// Today marks today's date
+	Today bool
+
+	// Past marks dates before today
+	Past bool
+
+	// Selected marks dates that are selected, or within a selected range
+	Selected bool
+
+	// Disabled marks dates that may not be active
+	Disabled bool
+
+	// Holiday marks holidays
+	Holiday bool
+}
+
+// DateStyleFunc provides the style of an individual date, e.g. to color weekends, holidays or data-driven values.
+//
+// The returned style is applied on top of the number style, the selection styles and the holiday style, so only the
+// properties that should differ need to be set. The disabled, today and active styles take precedence.
+type DateStyleFunc func(date time.Time, state DateState) gloss.Style
+
 // DefaultStyles provides default styles for the date block.
 func DefaultDateStyles() DateStyles {
 	// Default days-of-the-week labels are 3 characters, so this is 3-characters and 1-character
@@ -40,10 +94,87 @@ func DefaultDateStyles() DateStyles {
 			Align(gloss.Left).
 			Bold(true).
 			Foreground(DefaultActiveColor),
+		SelectedDateStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Left).
+			Bold(true).
+			Background(DefaultSelectedColor),
+		DisabledNumberStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Left).
+			Faint(true),
+		TodayNumberStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Left).
+			Underline(true),
+		HolidayNumberStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Left).
+			Foreground(DefaultHolidayColor),
+		HolidayLabelStyle: gloss.NewStyle().
+			Italic(true).
+			Foreground(DefaultHolidayColor),
+		SelectedStartStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Left).
+			Bold(true).
+			Background(DefaultSelectedColor),
+		SelectedMiddleStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Left).
+			Background(DefaultSelectedColor),
+		SelectedEndStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Left).
+			Bold(true).
+			Background(DefaultSelectedColor),
 		BodyStyle: gloss.NewStyle().
 			Width(defaultWidth).
 			Height(defaultHeight - 1).
 			Align(gloss.Center),
+		FocusedStyle: gloss.NewStyle().
+			Background(DefaultSelectedColor),
+
+		EventStyles: DefaultEventStyles(),
+
+		MoreStyle: gloss.NewStyle().
+			Faint(true),
+		MoreFormat: "+%d more",
+	}
+}
+
+// Styles for rendering events.
+type EventStyles struct {
+	// Timed events
+	EventStyle gloss.Style
+
+	// All-day events
+	AllDayStyle gloss.Style
+
+	// Styles by event category, which are applied over the timed or all-day event style
+	CategoryStyles map[string]gloss.Style
+
+	// Start time before the title of timed events, with a layout as understood by time.Format
+	TimeStyle  gloss.Style
+	TimeFormat string
+
+	// Multi-day events drawn as bars across dates, which is applied over the style of the event
+	BarStyle gloss.Style
+}
+
+// DefaultEventStyles provides default event styles.
+func DefaultEventStyles() EventStyles {
+	return EventStyles{
+		EventStyle: gloss.NewStyle(),
+		AllDayStyle: gloss.NewStyle().
+			Bold(true),
+
+		TimeStyle: gloss.NewStyle().
+			Faint(true),
+		TimeFormat: "15:04",
+
+		BarStyle: gloss.NewStyle().
+			Reverse(true),
 	}
 }
 
@@ -64,6 +195,10 @@ type MonthStyles struct {
 	BottomDayStyle      gloss.Style
 	BottomRightDayStyle gloss.Style
 
+	// Week number column, shown to the left of the month block when week numbers are enabled
+	WeekNumberHeaderStyle gloss.Style
+	WeekNumberStyle       gloss.Style
+
 	// Date interior
 	DateStyles DateStyles
 }
@@ -99,6 +234,18 @@ func DefaultMonthStyles() MonthStyles {
 			Border(DefaultBottomDayBorder, false, true, true, false),
 		BottomRightDayStyle: gloss.NewStyle().
 			Border(DefaultBottomRightDayBorder, false, true, true, false),
+
+		// Labels line up with the header text and day numbers, below the borders
+		WeekNumberHeaderStyle: gloss.NewStyle().
+			Width(5).
+			Align(gloss.Right).
+			Padding(1, 1, 0, 0).
+			Faint(true),
+		WeekNumberStyle: gloss.NewStyle().
+			Width(5).
+			Align(gloss.Right).
+			Padding(0, 1, 0, 0).
+			Faint(true),
 	}
 }
 
@@ -112,6 +259,12 @@ type WeekStyles struct {
 	// Style to indicate a day is the active day from the header
 	ActiveHeaderStyle gloss.Style
 
+	// Style to indicate a day is today from the header
+	TodayHeaderStyle gloss.Style
+
+	// Week number, shown to the left of the headers when week numbers are enabled
+	WeekNumberStyle gloss.Style
+
 	// Month block bottom row
 	LeftDayStyle   gloss.Style
 	MiddleDayStyle gloss.Style
@@ -119,9 +272,18 @@ type WeekStyles struct {
 
 	// Date interior
 	//
-	// Note: NumberStyle and ActiveNumber styles are ignored for WeekModel.
+	// Note: NumberStyle and ActiveNumber styles are ignored for WeekModel. Selected and disabled styles are applied to
+	// the header.
 	DateStyles DateStyles
+
+	// Layout of the date below each weekday label, as understood by Locale.Format. If empty, the locale's short date
+	// format is used.
 	DateFormat string
+
+	// Time grid, used when the time grid layout is enabled
+	//
+	// Note: Width, HeaderStyle and DateFormat are ignored for WeekModel.
+	TimeGridStyles DayStyles
 }
 
 func DefaultWeekStyles() WeekStyles {
@@ -147,6 +309,16 @@ func DefaultWeekStyles() WeekStyles {
 			Bold(true).
 			Foreground(DefaultActiveColor),
 
+		TodayHeaderStyle: gloss.NewStyle().
+			Underline(true),
+
+		// The label and number line up with the weekday label and date, below the border and padding
+		WeekNumberStyle: gloss.NewStyle().
+			Width(5).
+			Align(gloss.Right).
+			Padding(2, 1, 0, 0).
+			Faint(true),
+
 		LeftDayStyle: gloss.NewStyle().
 			Border(DefaultBottomLeftDayBorder, false, true, true, true).
 			Align(gloss.Center, gloss.Top).
@@ -164,17 +336,280 @@ func DefaultWeekStyles() WeekStyles {
 			Width:  defaultWidth,
 			Height: defaultHeight,
 
+			SelectedDateStyle: gloss.NewStyle().
+				Bold(true).
+				Background(DefaultSelectedColor),
+			DisabledNumberStyle: gloss.NewStyle().
+				Faint(true),
+			HolidayNumberStyle: gloss.NewStyle().
+				Foreground(DefaultHolidayColor),
+			HolidayLabelStyle: gloss.NewStyle().
+				Italic(true).
+				Foreground(DefaultHolidayColor),
+			SelectedStartStyle: gloss.NewStyle().
+				Bold(true).
+				Background(DefaultSelectedColor),
+			SelectedMiddleStyle: gloss.NewStyle().
+				Background(DefaultSelectedColor),
+			SelectedEndStyle: gloss.NewStyle().
+				Bold(true).
+				Background(DefaultSelectedColor),
 			BodyStyle: gloss.NewStyle().
 				Width(defaultWidth).
 				Height(defaultHeight - 1).
 				Align(gloss.Center),
+			FocusedStyle: gloss.NewStyle().
+				Background(DefaultSelectedColor),
+
+			EventStyles: DefaultEventStyles(),
+
+			MoreStyle: gloss.NewStyle().
+				Faint(true),
+			MoreFormat: "+%d more",
 		},
-		DateFormat: "1/02",
+		DateFormat: "",
+
+		TimeGridStyles: DefaultDayStyles(),
+	}
+}
+
+// Styles for rendering the year overview.
+type YearStyles struct {
+	// Width of each date cell
+	CellWidth int
+
+	// Month name above each month
+	TitleStyle gloss.Style
+
+	// Days-of-the-week header
+	HeaderStyle gloss.Style
+
+	// Date number style
+	NumberStyle gloss.Style
+
+	ActiveNumberStyle gloss.Style
+
+	// Month block, e.g. for spacing between months
+	MonthStyle gloss.Style
+}
+
+// DefaultYearStyles provides default year styles.
+func DefaultYearStyles() YearStyles {
+	// Two-digit dates plus 1-character of left padding.
+	defaultWidth := 3
+
+	return YearStyles{
+		CellWidth: defaultWidth,
+
+		TitleStyle: gloss.NewStyle().
+			Align(gloss.Center).
+			Bold(true),
+		HeaderStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Right).
+			Faint(true),
+		NumberStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Right),
+		ActiveNumberStyle: gloss.NewStyle().
+			Width(defaultWidth).
+			Align(gloss.Right).
+			Bold(true).
+			Foreground(DefaultActiveColor),
+		MonthStyle: gloss.NewStyle().
+			Padding(0, 1, 1, 1),
+	}
+}
+
+// Styles for rendering a heatmap.
+type HeatmapStyles struct {
+	// Width of each date cell
+	CellWidth int
+
+	// Glyphs drawn in date cells, colored by the scale
+	Glyph       string
+	ActiveGlyph string
+
+	// Date cell style, whose foreground is set by the scale
+	CellStyle       gloss.Style
+	ActiveCellStyle gloss.Style
+
+	// Weekday labels to the left of each row
+	RowLabelStyle gloss.Style
+
+	// Month names above the weeks
+	MonthLabelStyle gloss.Style
+
+	// Legend labels at either end of the scale
+	LegendStyle gloss.Style
+	LegendLess  string
+	LegendMore  string
+}
+
+// DefaultHeatmapStyles provides default heatmap styles.
+func DefaultHeatmapStyles() HeatmapStyles {
+	return HeatmapStyles{
+		CellWidth: 2,
+
+		Glyph:       "■",
+		ActiveGlyph: "▣",
+
+		CellStyle: gloss.NewStyle(),
+		ActiveCellStyle: gloss.NewStyle().
+			Bold(true),
+
+		RowLabelStyle: gloss.NewStyle().
+			PaddingRight(1).
+			Faint(true),
+		MonthLabelStyle: gloss.NewStyle().
+			Faint(true),
+
+		LegendStyle: gloss.NewStyle().
+			Faint(true),
+		LegendLess: "Less",
+		LegendMore: "More",
+	}
+}
+
+// Styles for rendering a day as a timeline.
+type DayStyles struct {
+	// Width of the time slot column
+	Width int
+
+	// Date header above the timeline, with a layout as understood by Locale.Format. If the layout is empty, the
+	// locale's long date format is used.
+	HeaderStyle gloss.Style
+	DateFormat  string
+
+	// Time labels in the left gutter
+	TimeStyle       gloss.Style
+	ActiveTimeStyle gloss.Style
+	TimeFormat      string
+
+	// Time slots without appointments
+	SlotStyle       gloss.Style
+	ActiveSlotStyle gloss.Style
+
+	// Time slots occupied by an appointment
+	AppointmentStyle gloss.Style
+}
+
+// DefaultDayStyles provides default day styles.
+func DefaultDayStyles() DayStyles {
+	defaultWidth := 30
+
+	return DayStyles{
+		Width: defaultWidth,
+
+		HeaderStyle: gloss.NewStyle().
+			Align(gloss.Center).
+			Bold(true),
+		DateFormat: "",
+
+		TimeStyle: gloss.NewStyle().
+			Width(6).
+			Align(gloss.Left).
+			Faint(true),
+		ActiveTimeStyle: gloss.NewStyle().
+			Width(6).
+			Align(gloss.Left).
+			Bold(true).
+			Foreground(DefaultActiveColor),
+		TimeFormat: "15:04",
+
+		SlotStyle: gloss.NewStyle().
+			Faint(true),
+		ActiveSlotStyle: gloss.NewStyle().
+			Reverse(true),
+
+		AppointmentStyle: gloss.NewStyle().
+			Foreground(DefaultActiveColor),
+	}
+}
+
+// Styles for rendering a date picker.
+type DatePickerStyles struct {
+	// Month and year above the calendar
+	TitleStyle gloss.Style
+
+	// Text field for typing a date
+	InputStyle        gloss.Style
+	ActiveInputStyle  gloss.Style
+	InvalidInputStyle gloss.Style
+	PlaceholderStyle  gloss.Style
+	CursorStyle       gloss.Style
+
+	// Compact calendar
+	MonthStyles MonthStyles
+
+	// Frame around the date picker when rendered inline
+	InlineStyle gloss.Style
+
+	// Frame around the date picker when rendered as a popover
+	PopoverStyle gloss.Style
+}
+
+// DefaultDatePickerStyles provides default date picker styles.
+func DefaultDatePickerStyles() DatePickerStyles {
+	// Two-digit dates plus 1-character of left padding.
+	defaultWidth := 3
+
+	numberStyle := gloss.NewStyle().
+		Width(defaultWidth).
+		Align(gloss.Right)
+	headerStyle := gloss.NewStyle().
+		Align(gloss.Right).
+		Faint(true)
+
+	return DatePickerStyles{
+		TitleStyle: gloss.NewStyle().
+			Align(gloss.Center).
+			Bold(true),
+
+		InputStyle: gloss.NewStyle().
+			Faint(true),
+		ActiveInputStyle: gloss.NewStyle().
+			Foreground(DefaultActiveColor),
+		InvalidInputStyle: gloss.NewStyle().
+			Foreground(DefaultInvalidColor),
+		PlaceholderStyle: gloss.NewStyle().
+			Faint(true),
+		CursorStyle: gloss.NewStyle().
+			Reverse(true),
+
+		MonthStyles: MonthStyles{
+			LeftHeaderStyle:   headerStyle,
+			MiddleHeaderStyle: headerStyle,
+			RightHeaderStyle:  headerStyle,
+
+			DateStyles: DateStyles{
+				Width:  defaultWidth,
+				Height: 1,
+
+				NumberStyle: numberStyle,
+				ActiveNumberStyle: numberStyle.
+					Bold(true).
+					Reverse(true),
+				DisabledNumberStyle: numberStyle.
+					Faint(true),
+				TodayNumberStyle: numberStyle.
+					Underline(true),
+				HolidayNumberStyle: numberStyle.
+					Foreground(DefaultHolidayColor),
+			},
+		},
+
+		PopoverStyle: gloss.NewStyle().
+			Border(gloss.RoundedBorder()).
+			Padding(0, 1),
 	}
 }
 
 var (
-	DefaultActiveColor = gloss.AdaptiveColor{Light: "#3E5AFA", Dark: "#7DD6FA"}
+	DefaultActiveColor   = gloss.AdaptiveColor{Light: "#3E5AFA", Dark: "#7DD6FA"}
+	DefaultSelectedColor = gloss.AdaptiveColor{Light: "#D5DCFE", Dark: "#2F3F6B"}
+	DefaultInvalidColor  = gloss.AdaptiveColor{Light: "#D7263D", Dark: "#FF6B7A"}
+	DefaultHolidayColor  = gloss.AdaptiveColor{Light: "#B8336A", Dark: "#F28FB8"}
 
 	// ╭───┬
 	// │Sun│
@@ -0,0 +1,283 @@
+package calendar
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Appointment is an item with a start and end time that is positioned on a timeline.
+type Appointment struct {
+	// Title to display
+	Title string
+
+	// Start of the appointment
+	Start time.Time
+
+	// End of the appointment
+	End time.Time
+}
+
+// AppointmentsMsg sets the appointments placed on the timeline of a single day.
+type AppointmentsMsg struct {
+	// The day to update
+	Date time.Time
+
+	// Appointments for the day, replacing any existing appointments
+	Appointments []Appointment
+}
+
+// timeGrid describes the time slots of a timeline.
+type timeGrid struct {
+	// startTime is the offset from midnight of the first time slot
+	startTime time.Duration
+	// endTime is the offset from midnight of the end of the last time slot
+	endTime time.Duration
+	// slotDuration is the length of each time slot
+	slotDuration time.Duration
+}
+
+// defaultTimeGrid provides a timeline of 30-minute slots from 07:00 to 20:00.
+func defaultTimeGrid() timeGrid {
+	return timeGrid{
+		startTime:    7 * time.Hour,
+		endTime:      20 * time.Hour,
+		slotDuration: 30 * time.Minute,
+	}
+}
+
+// slots calculates the number of time slots in the timeline.
+func (g timeGrid) slots() int {
+	return int((g.endTime - g.startTime) / g.slotDuration)
+}
+
+// slotStart calculates the start time of a time slot on a date. Slots are laid out by wall clock, so that a slot starts
+// at the same time of day on dates where daylight saving time begins or ends.
+func (g timeGrid) slotStart(date time.Time, slot int) time.Time {
+	offset := g.startTime + (time.Duration(slot) * g.slotDuration)
+	y, m, d := date.Date()
+	return time.Date(
+		y,
+		m,
+		d,
+		int(offset/time.Hour),
+		int((offset%time.Hour)/time.Minute),
+		int((offset%time.Minute)/time.Second),
+		0,
+		date.Location(),
+	)
+}
+
+// wallOffset calculates the wall clock time of t since midnight at the start of a date, in the date's location. Times
+// on later dates are offset by a whole day per date, regardless of daylight saving time.
+func wallOffset(date time.Time, t time.Time) time.Duration {
+	t = t.In(date.Location())
+	y, m, d := date.Date()
+	ty, tm, td := t.Date()
+	days := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC).Sub(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	return days +
+		(time.Duration(t.Hour()) * time.Hour) +
+		(time.Duration(t.Minute()) * time.Minute) +
+		(time.Duration(t.Second()) * time.Second) +
+		time.Duration(t.Nanosecond())
+}
+
+// placement is the position of an appointment within a time grid column.
+type placement struct {
+	appointment Appointment
+
+	// first and last time slot the appointment overlaps
+	first int
+	last  int
+
+	// lane of the appointment and number of lanes shared with overlapping appointments
+	lane  int
+	lanes int
+}
+
+// place positions appointments on a date so that appointments sharing a time slot are placed side-by-side.
+//
+// Appointments that do not overlap the timeline are omitted.
+func (g timeGrid) place(date time.Time, appointments []Appointment) []placement {
+	var placements []placement
+	for _, a := range appointments {
+		if !a.End.After(a.Start) {
+			continue
+		}
+
+		// Appointments are placed by wall clock, as the slots are
+		start := wallOffset(date, a.Start) - g.startTime
+		end := wallOffset(date, a.End) - g.startTime
+		first := int(start / g.slotDuration)
+		if start < 0 {
+			first = 0
+		}
+		last := max(first, int((end-1)/g.slotDuration))
+		if (end <= 0) || (first >= g.slots()) {
+			continue
+		}
+		placements = append(placements, placement{
+			appointment: a,
+			first:       first,
+			last:        min(last, g.slots()-1),
+		})
+	}
+
+	// Place earlier appointments first, and longer appointments before shorter ones
+	slices.SortStableFunc(placements, func(a, b placement) int {
+		if a.first != b.first {
+			return a.first - b.first
+		}
+		return b.last - a.last
+	})
+
+	// Group appointments into clusters of transitively overlapping appointments and assign each appointment the
+	// first lane that is free.
+	var cluster []int
+	var laneEnds []int
+	clusterEnd := -1
+	closeCluster := func() {
+		for _, i := range cluster {
+			placements[i].lanes = len(laneEnds)
+		}
+		cluster = nil
+		laneEnds = nil
+	}
+	for i, p := range placements {
+		if p.first > clusterEnd {
+			closeCluster()
+		}
+
+		lane := slices.IndexFunc(laneEnds, func(end int) bool { return end < p.first })
+		if lane < 0 {
+			lane = len(laneEnds)
+			laneEnds = append(laneEnds, p.last)
+		}
+		laneEnds[lane] = p.last
+
+		placements[i].lane = lane
+		cluster = append(cluster, i)
+		clusterEnd = max(clusterEnd, p.last)
+	}
+	closeCluster()
+
+	return placements
+}
+
+// viewGutter renders the time labels for time slots from offset to offset+count, one line per slot.
+func (g timeGrid) viewGutter(date time.Time, offset int, count int, activeSlot int, styles DayStyles) []string {
+	var lines []string
+	for i := offset; i < offset+count; i++ {
+		start := g.slotStart(date, i)
+
+		style := styles.TimeStyle
+		if i == activeSlot {
+			style = styles.ActiveTimeStyle
+		}
+
+		label := ""
+		if (i == offset) || (start.Minute() == 0) {
+			label = start.Format(styles.TimeFormat)
+		}
+		lines = append(lines, style.Render(label))
+	}
+
+	return lines
+}
+
+// viewColumn renders the time slots from offset to offset+count for a date, one line per slot.
+//
+// An appointment's title is shown in the first visible time slot it overlaps; subsequent time slots show a
+// continuation marker.
+func (g timeGrid) viewColumn(
+	date time.Time,
+	appointments []Appointment,
+	width int,
+	offset int,
+	count int,
+	activeSlot int,
+	styles DayStyles,
+) []string {
+	placements := g.place(date, appointments)
+
+	var lines []string
+	for i := offset; i < offset+count; i++ {
+		start := g.slotStart(date, i)
+
+		slotStyle := styles.SlotStyle
+		if i == activeSlot {
+			slotStyle = styles.ActiveSlotStyle.Inherit(slotStyle)
+		}
+		appointmentStyle := styles.AppointmentStyle.Inherit(slotStyle)
+
+		// Draw a faint line at the top of every hour
+		empty := " "
+		if start.Minute() == 0 {
+			empty = "┈"
+		}
+
+		// Appointments sharing a time slot always belong to the same cluster, so ordering by lane is sufficient to
+		// render them left-to-right
+		var row []placement
+		for _, p := range placements {
+			if (i >= p.first) && (i <= p.last) {
+				row = append(row, p)
+			}
+		}
+		slices.SortFunc(row, func(a, b placement) int { return a.lane - b.lane })
+
+		var b strings.Builder
+		x := 0
+		for _, p := range row {
+			// Split the column evenly between lanes, giving any remainder to the last lane
+			laneWidth := width / p.lanes
+			laneX := p.lane * laneWidth
+			if p.lane == p.lanes-1 {
+				laneWidth = width - laneX
+			}
+			if laneX < x || laneWidth <= 0 {
+				continue
+			}
+
+			if laneX > x {
+				b.WriteString(slotStyle.Render(strings.Repeat(empty, laneX-x)))
+			}
+
+			text := "▌"
+			if (i == offset) || (i == p.first) {
+				text = ansi.Truncate("▌"+p.appointment.Title, laneWidth, "…")
+			}
+			b.WriteString(appointmentStyle.Width(laneWidth).Render(text))
+			x = laneX + laneWidth
+		}
+		if x < width {
+			b.WriteString(slotStyle.Render(strings.Repeat(empty, width-x)))
+		}
+
+		lines = append(lines, b.String())
+	}
+
+	return lines
+}
+
+// normalizeDate truncates a time to midnight in its location, which is used as the key for per-date content.
+func normalizeDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
+
+// dateIn converts a time to a location and truncates it to midnight, giving the date on which the time falls in that
+// location.
+func dateIn(date time.Time, loc *time.Location) time.Time {
+	return normalizeDate(date.In(loc))
+}
+
+// sameDateIn re-expresses the calendar date of a time, as seen in its own location, as midnight in another location.
+// The zero time is kept as is.
+func sameDateIn(date time.Time, loc *time.Location) time.Time {
+	if date == (time.Time{}) {
+		return date
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_timeGrid_place(t *testing.T) {
+	date := newDate(2024, time.October, 1)
+
+	tests := []struct {
+		name         string
+		appointments []Appointment
+		want         []placement
+	}{
+		{
+			name: "sequential",
+			appointments: []Appointment{
+				{Title: "a", Start: newTime(2024, time.October, 1, 8, 0), End: newTime(2024, time.October, 1, 9, 0)},
+				{Title: "b", Start: newTime(2024, time.October, 1, 9, 0), End: newTime(2024, time.October, 1, 9, 30)},
+			},
+			want: []placement{
+				{first: 2, last: 3, lane: 0, lanes: 1},
+				{first: 4, last: 4, lane: 0, lanes: 1},
+			},
+		},
+		{
+			name: "overlapping",
+			appointments: []Appointment{
+				{Title: "a", Start: newTime(2024, time.October, 1, 9, 0), End: newTime(2024, time.October, 1, 10, 30)},
+				{Title: "b", Start: newTime(2024, time.October, 1, 9, 15), End: newTime(2024, time.October, 1, 9, 45)},
+				{Title: "c", Start: newTime(2024, time.October, 1, 10, 0), End: newTime(2024, time.October, 1, 11, 0)},
+			},
+			want: []placement{
+				{first: 4, last: 6, lane: 0, lanes: 2},
+				{first: 4, last: 5, lane: 1, lanes: 2},
+				{first: 6, last: 7, lane: 1, lanes: 2},
+			},
+		},
+		{
+			name: "three-lanes",
+			appointments: []Appointment{
+				{Title: "a", Start: newTime(2024, time.October, 1, 9, 0), End: newTime(2024, time.October, 1, 10, 0)},
+				{Title: "b", Start: newTime(2024, time.October, 1, 9, 0), End: newTime(2024, time.October, 1, 10, 0)},
+				{Title: "c", Start: newTime(2024, time.October, 1, 9, 30), End: newTime(2024, time.October, 1, 10, 0)},
+			},
+			want: []placement{
+				{first: 4, last: 5, lane: 0, lanes: 3},
+				{first: 4, last: 5, lane: 1, lanes: 3},
+				{first: 5, last: 5, lane: 2, lanes: 3},
+			},
+		},
+		{
+			name: "clipped-to-timeline",
+			appointments: []Appointment{
+				{Title: "a", Start: newTime(2024, time.October, 1, 6, 0), End: newTime(2024, time.October, 1, 7, 30)},
+				{Title: "b", Start: newTime(2024, time.October, 1, 19, 30), End: newTime(2024, time.October, 1, 21, 0)},
+			},
+			want: []placement{
+				{first: 0, last: 0, lane: 0, lanes: 1},
+				{first: 25, last: 25, lane: 0, lanes: 1},
+			},
+		},
+		{
+			name: "outside-timeline",
+			appointments: []Appointment{
+				{Title: "a", Start: newTime(2024, time.October, 1, 5, 0), End: newTime(2024, time.October, 1, 7, 0)},
+				{Title: "b", Start: newTime(2024, time.October, 1, 20, 0), End: newTime(2024, time.October, 1, 21, 0)},
+				{Title: "c", Start: newTime(2024, time.October, 1, 12, 0), End: newTime(2024, time.October, 1, 12, 0)},
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			g := defaultTimeGrid()
+
+			// Test
+			got := g.place(date, tt.appointments)
+
+			// Assertions
+			for i := range got {
+				got[i].appointment = Appointment{}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
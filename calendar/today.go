@@ -0,0 +1,47 @@
+package calendar
+
+import (
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TodayMsg notifies to other models that the current date has changed. It is sent at midnight by models with midnight
+// refreshes enabled.
+type TodayMsg struct {
+	// The new current date
+	Date time.Time
+
+	// id of the model whose tick produced the message
+	id int64
+}
+
+// lastID is the most recently assigned model ID.
+var lastID int64
+
+// nextID assigns a unique ID, which is used to route TodayMsg ticks back to the model that started them.
+func nextID() int64 {
+	return atomic.AddInt64(&lastID, 1)
+}
+
+// today calculates the current date in a location from a clock, as midnight in the location.
+func today(clock func() time.Time, loc *time.Location) time.Time {
+	return dateIn(clock(), loc)
+}
+
+// untilMidnight calculates the time remaining until the next midnight in the time's location.
+func untilMidnight(now time.Time) time.Duration {
+	midnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	return midnight.Sub(now)
+}
+
+// midnightTick waits until the next midnight in a location and then reports the new current date.
+func midnightTick(id int64, clock func() time.Time, loc *time.Location) tea.Cmd {
+	return tea.Tick(untilMidnight(clock().In(loc)), func(time.Time) tea.Msg {
+		return TodayMsg{
+			Date: today(clock, loc),
+			id:   id,
+		}
+	})
+}
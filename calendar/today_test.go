@@ -0,0 +1,131 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixedClock returns a clock that always reports the given time.
+func fixedClock(now time.Time) func() time.Time {
+	return func() time.Time { return now }
+}
+
+func Test_untilMidnight(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Duration
+	}{
+		{
+			name: "evening",
+			now:  time.Date(2024, time.September, 10, 23, 30, 0, 0, time.UTC),
+			want: 30 * time.Minute,
+		},
+		{
+			name: "midnight",
+			now:  time.Date(2024, time.September, 10, 0, 0, 0, 0, time.UTC),
+			want: 24 * time.Hour,
+		},
+		{
+			name: "daylight-saving",
+			now:  time.Date(2024, time.March, 9, 12, 0, 0, 0, mustLoadLocation("America/New_York")),
+			want: 12 * time.Hour,
+		},
+		{
+			name: "daylight-saving-day",
+			now:  time.Date(2024, time.March, 10, 0, 0, 0, 0, mustLoadLocation("America/New_York")),
+			want: 23 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := untilMidnight(tt.now)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+func TestMonthModel_Today(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).
+		Clock(fixedClock(time.Date(2024, time.November, 5, 9, 0, 0, 0, time.UTC)))
+
+	// Test
+	got, gotCmd := tm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+
+	// Assertions
+	assert.Equal(t, newDate(2024, time.November, 5), tm.Today())
+	assert.Equal(t, newDate(2024, time.November, 5), got.(MonthModel).ActiveDate())
+	assert.Equal(t, []tea.Msg{
+		MonthChangedMsg{Year: 2024, Month: time.November},
+		ActiveDateMsg{Date: newDate(2024, time.November, 5)},
+	}, collectMsgs(gotCmd))
+}
+
+func TestMonthModel_JumpToToday_Disabled(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September).
+		Clock(fixedClock(time.Date(2024, time.November, 9, 9, 0, 0, 0, time.UTC))).
+		DisabledFunc(isWeekend)
+
+	// Test
+	got := tm.JumpToToday()
+
+	// Assertions
+	assert.Equal(t, newDate(2024, time.November, 11), got.ActiveDate())
+}
+
+func TestWeekModel_Today(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.September, 24)).
+		Clock(fixedClock(time.Date(2024, time.October, 9, 18, 0, 0, 0, time.UTC)))
+
+	// Test
+	got, gotCmd := tm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+
+	// Assertions
+	assert.Equal(t, newDate(2024, time.October, 6), got.(WeekModel).startDate)
+	assert.Equal(t, newDate(2024, time.October, 9), got.(WeekModel).activeDate)
+	assert.Equal(t, []tea.Msg{
+		WeekChangedMsg{Start: newDate(2024, time.October, 6), End: newDate(2024, time.October, 12)},
+		ActiveDateMsg{Date: newDate(2024, time.October, 9)},
+	}, collectMsgs(gotCmd))
+}
+
+func TestMonthModel_RefreshAtMidnight(t *testing.T) {
+	// Setup
+	tm := NewMonth(2024, time.September)
+	other := NewMonth(2024, time.September).RefreshAtMidnight(true)
+
+	// Test
+	gotInit := tm.Init()
+
+	// Assertions
+	assert.Nil(t, gotInit)
+
+	// Test
+	tm = tm.RefreshAtMidnight(true)
+	gotInit = tm.Init()
+	_, gotOwn := tm.Update(TodayMsg{Date: newDate(2024, time.September, 11), id: tm.id})
+	_, gotOther := tm.Update(TodayMsg{Date: newDate(2024, time.September, 11), id: other.id})
+
+	// Assertions
+	assert.NotNil(t, gotInit)
+	assert.NotNil(t, gotOwn)
+	assert.Nil(t, gotOther)
+}
@@ -1,13 +1,17 @@
 package calendar
 
 import (
+	"cmp"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // Weekdays maps weekdays to their labels.
@@ -55,7 +59,7 @@ func (w Weekdays) IsVisible(weekday time.Weekday) bool {
 
 // First returns the first visible weekday based on the start date.
 func (w Weekdays) First(startDate time.Time) time.Weekday {
-	startDate = time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC)
+	startDate = normalizeDate(startDate)
 	for i := 0; i < 7; i++ {
 		wd := startDate.AddDate(0, 0, i).Weekday()
 		if w.IsVisible(wd) {
@@ -67,7 +71,7 @@ func (w Weekdays) First(startDate time.Time) time.Weekday {
 
 // Last returns the last visible weekday based on the start date.
 func (w Weekdays) Last(startDate time.Time) time.Weekday {
-	startDate = time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC)
+	startDate = normalizeDate(startDate)
 	for i := 1; i < 7; i++ {
 		wd := startDate.AddDate(0, 0, (-1 * i)).Weekday()
 		if w.IsVisible(wd) {
@@ -105,6 +109,19 @@ func (w Weekdays) Spread(startDate time.Time) int {
 	return spread
 }
 
+// visibleDate steps from the date in the direction of step until a date with a visible weekday is found.
+//
+// If no weekday is visible, the date is returned unchanged.
+func visibleDate(weekdays Weekdays, date time.Time, step int) time.Time {
+	for i := 1; i <= 7; i++ {
+		d := date.AddDate(0, 0, step*i)
+		if weekdays.IsVisible(d.Weekday()) {
+			return d
+		}
+	}
+	return date
+}
+
 // DefaultWeekdays returns default weekday labels.
 func DefaultWeekdays() Weekdays {
 	return Weekdays{
@@ -131,8 +148,20 @@ func DefaultWeekdaysShort() Weekdays {
 	}
 }
 
+// WeekChangedMsg notifies to other models that the WeekModel now represents a different week.
+type WeekChangedMsg struct {
+	// First date of the week now represented
+	Start time.Time
+
+	// Last date of the week now represented
+	End time.Time
+}
+
 // WeekModel represents a calendar week.
 type WeekModel struct {
+	// id routes midnight ticks back to this model
+	id int64
+
 	// keyMap is key bindings for calendar navigation
 	keyMap KeyMap
 
@@ -142,32 +171,106 @@ type WeekModel struct {
 	// weekdays manages labels for weekdays
 	weekdays Weekdays
 
+	// locale provides date formats
+	locale Locale
+
+	// location is the time zone in which dates are constructed and incoming times are interpreted
+	location *time.Location
+
 	// week to represent
 	startDate time.Time
 
 	// days contains user-provided information about each day
 	days map[time.Time]tea.Model
 
+	// events provides the events shown on days without content and placed on the time grid
+	events EventSource
+	// eventRenderer renders the events of a day
+	eventRenderer EventRenderer
+
 	activeDate time.Time
 
+	// bounds restricts which dates may be active
+	bounds dateBounds
+
+	// selection tracks selected dates
+	selection selection
+
+	// showTimeGrid enables the time grid layout, where appointments are placed by time of day
+	showTimeGrid bool
+	// grid describes the time slots of the time grid layout
+	grid timeGrid
+	// appointments contains user-provided appointments for each day
+	appointments map[time.Time][]Appointment
+
+	// clock provides the current time, which determines today's date
+	clock func() time.Time
+	// refreshAtMidnight enables a tick that reports the new current date at midnight
+	refreshAtMidnight bool
+
+	// showWeekNumbers enables the week number before the headers
+	showWeekNumbers bool
+
+	// dateStyleFunc provides optional per-date styling
+	dateStyleFunc DateStyleFunc
+
+	// holidays provides the holidays shown on dates
+	holidays HolidayProvider
+	// showHolidayLabels enables the name of each holiday at the top of its date
+	showHolidayLabels bool
+	// skipHolidays prevents holidays from becoming active
+	skipHolidays bool
+
+	// offsetX and offsetY are the position of the view within the terminal, which locates mouse events
+	offsetX int
+	offsetY int
+	// clicks recognizes double clicks
+	clicks clickTracker
+
+	// width and height are the target size of the view, or zero to use the size of the styles
+	width  int
+	height int
+	// fitWindow sizes the view to the terminal window
+	fitWindow bool
+	// sized marks a copy whose styles are already fitted to the target size
+	sized bool
+
+	// overflow determines how content that does not fit a date is shown
+	overflow Overflow
+	// scroll is the scroll offset of the active date's content
+	scroll int
+
+	// focusedDate is the date whose content receives key presses, or the zero time while no content has focus
+	focusedDate time.Time
+
 	// Styles
 	styles WeekStyles
 }
 
 // NewWeek creates a new WeekModel.
 func NewWeek(sampleDate time.Time) WeekModel {
-	startDay := sampleDate.AddDate(0, 0, (-1 * int(sampleDate.Weekday())))
+	startDay := sameDateIn(sampleDate, time.UTC).AddDate(0, 0, (-1 * int(sampleDate.Weekday())))
 
 	m := WeekModel{
+		id:     nextID(),
 		keyMap: DefaultWeekKeyMap(),
 
 		startOfWeek: time.Sunday,
 		weekdays:    DefaultWeekdays(),
+		locale:      DefaultLocale(),
+		location:    time.UTC,
 
 		startDate:  startDay,
 		days:       make(map[time.Time]tea.Model),
 		activeDate: time.Time{},
 
+		eventRenderer: EventListRenderer,
+
+		grid:         defaultTimeGrid(),
+		appointments: make(map[time.Time][]Appointment),
+
+		clock: time.Now,
+
 		styles: DefaultWeekStyles(),
 	}
 
@@ -191,103 +294,553 @@ func (m WeekModel) Weekdays(weekdays Weekdays) WeekModel {
 	return m
 }
 
+// Locale sets the weekday labels, date format and first day of the week.
+//
+// Weekday labels and the first day of the week may still be overridden afterwards with Weekdays and StartOfWeek.
+func (m WeekModel) Locale(locale Locale) WeekModel {
+	m = m.StartOfWeek(locale.FirstDayOfWeek)
+	m.locale = locale
+	m.weekdays = locale.Weekdays
+	return m
+}
+
+// Location sets the time zone in which dates are constructed, which defaults to UTC. A nil location is treated as UTC.
+//
+// Times received in messages, such as DayContentMsg and AppointmentsMsg, are converted to the location to find the date
+// they fall on, and dates reported in messages, such as ActiveDateMsg, are midnight in the location. Dates that are
+// already set, e.g. the represented week, content, bounds and selected dates, keep their calendar date.
+func (m WeekModel) Location(loc *time.Location) WeekModel {
+	if loc == nil {
+		loc = time.UTC
+	}
+	m.location = loc
+
+	m.startDate = sameDateIn(m.startDate, loc)
+	m.activeDate = sameDateIn(m.activeDate, loc)
+	m.focusedDate = sameDateIn(m.focusedDate, loc)
+
+	days := make(map[time.Time]tea.Model, len(m.days))
+	for date, content := range m.days {
+		days[sameDateIn(date, loc)] = content
+	}
+	m.days = days
+	appointments := make(map[time.Time][]Appointment, len(m.appointments))
+	for date, a := range m.appointments {
+		appointments[sameDateIn(date, loc)] = a
+	}
+	m.appointments = appointments
+	m.bounds = m.bounds.in(loc)
+	m.selection = m.selection.in(loc)
+
+	return m
+}
+
+// Events sets the source of the events shown in the body of each day, which is queried for the days of the week each
+// time the week is rendered. Days with content set by a DayContentMsg show their content instead.
+//
+// In the time grid layout, timed events are placed alongside appointments, and all-day events are not shown.
+func (m WeekModel) Events(source EventSource) WeekModel {
+	m.events = source
+	return m
+}
+
+// VisibleEvents returns the events of the represented week, as provided by the event source, e.g. to be written with
+// WriteICS. If the source is a RecurringEventSource, such as an EventList, recurring events are returned once with
+// their recurrence rather than as each of their occurrences. Content set with a DayContentMsg and appointments set
+// with an AppointmentsMsg are not included.
+func (m WeekModel) VisibleEvents() []Event {
+	return visibleEvents(m.events, m.startDate, m.startDate.AddDate(0, 0, 7))
+}
+
+// EventRenderer sets how the events of a day are rendered. Defaults to EventListRenderer.
+func (m WeekModel) EventRenderer(renderer EventRenderer) WeekModel {
+	m.eventRenderer = renderer
+	return m
+}
+
+// WeekNumbers enables or disables the number of the week to the left of the headers, as determined by the locale's
+// week numbering.
+func (m WeekModel) WeekNumbers(enabled bool) WeekModel {
+	m.showWeekNumbers = enabled
+	return m
+}
+
+// DateStyleFunc sets a function that provides styling for the date labels of individual dates.
+func (m WeekModel) DateStyleFunc(f DateStyleFunc) WeekModel {
+	m.dateStyleFunc = f
+	return m
+}
+
+// Holidays sets the provider of the holidays shown on dates, such as USFederalHolidays. Holidays are rendered with the
+// holiday number style.
+func (m WeekModel) Holidays(provider HolidayProvider) WeekModel {
+	m.holidays = provider
+	return m.SkipHolidays(m.skipHolidays)
+}
+
+// HolidayLabels enables or disables the name of each holiday at the top of its date, in the dates layout.
+func (m WeekModel) HolidayLabels(enabled bool) WeekModel {
+	m.showHolidayLabels = enabled
+	return m
+}
+
+// SkipHolidays enables or disables skipping holidays when navigating, in which case holidays may not be active.
+func (m WeekModel) SkipHolidays(enabled bool) WeekModel {
+	m.skipHolidays = enabled
+	m.bounds.holidays = nil
+	if enabled {
+		m.bounds.holidays = m.holidays
+	}
+	return m
+}
+
+// Holiday finds the holiday on a date, if any.
+func (m WeekModel) Holiday(date time.Time) (Holiday, bool) {
+	return holidayOn(m.holidays, dateIn(date, m.location))
+}
+
+// Offset sets the position of the top-left corner of the view within the terminal, so that mouse events may be mapped
+// to dates. Defaults to the top-left corner of the terminal.
+func (m WeekModel) Offset(x int, y int) WeekModel {
+	m.offsetX = x
+	m.offsetY = y
+	return m
+}
+
+// Size sets the target width and height of the rendered calendar, to which the dates are fitted. If bordered dates
+// would be too narrow for the weekday labels, short weekday labels are used, and if they would still be too narrow or
+// too short, a compact borderless layout with short weekday labels and day numbers is used instead. Zero keeps the
+// width or height of the styles.
+//
+// In the time grid layout only the width is fitted, as the height follows from the time range.
+func (m WeekModel) Size(width int, height int) WeekModel {
+	m.width = max(0, width)
+	m.height = max(0, height)
+	return m
+}
+
+// FitWindow enables or disables sizing the calendar to the terminal window whenever a tea.WindowSizeMsg is received.
+func (m WeekModel) FitWindow(enabled bool) WeekModel {
+	m.fitWindow = enabled
+	return m
+}
+
+// fit adapts the styles and weekday labels to the target size, if one is set.
+func (m WeekModel) fit() WeekModel {
+	if m.sized || ((m.width == 0) && (m.height == 0)) {
+		return m
+	}
+	m.sized = true
+
+	columns := visibleWeekdays(m.weekdays)
+	left := 0
+	switch {
+	case m.showTimeGrid:
+		left = gloss.Width(m.styles.TimeGridStyles.TimeStyle.Render(""))
+	case m.showWeekNumbers:
+		left = gloss.Width(m.styles.WeekNumberStyle.Render(""))
+	}
+	fitHeight := (m.height > 0) && !m.showTimeGrid
+
+	// Bordered dates share borders with their neighbors, so each date has a single border on the right, and the first
+	// column has an extra border on the left
+	width, height := m.styles.DateStyles.Width, m.styles.DateStyles.Height
+	frame := m.styles.MiddleDayStyle.GetHorizontalFrameSize()
+	first := m.styles.LeftDayStyle.GetHorizontalFrameSize() - frame
+	if m.width > 0 {
+		width = (m.width-left-first)/columns - frame
+	}
+	if fitHeight {
+		headers := gloss.Height(m.styles.LeftHeaderStyle.Render("\n"))
+		height = m.height - headers - m.styles.MiddleDayStyle.GetVerticalFrameSize()
+	}
+
+	// Each header holds a weekday label above a date, and the widest dates are at the end of a month
+	dates := 0
+	for month := time.January; month <= time.December; month++ {
+		date := time.Date(2000, month, 28, 0, 0, 0, 0, time.UTC)
+		dates = max(dates, gloss.Width(m.locale.Format(date, cmp.Or(m.styles.DateFormat, m.locale.ShortDateFormat))))
+	}
+	padding := m.styles.MiddleHeaderStyle.GetHorizontalPadding()
+	short := shortWeekdays(m.weekdays, m.locale)
+	if (width >= max(dates, maxLabelWidth(m.weekdays))+padding) && (height >= 1) {
+		m.styles.DateStyles = m.styles.DateStyles.resized(width, height)
+		return m
+	}
+	if (width >= max(dates, maxLabelWidth(short))+padding) && (height >= 1) {
+		m.weekdays = short
+		m.styles.DateStyles = m.styles.DateStyles.resized(width, height)
+		return m
+	}
+
+	// Compact dates fill the space without borders, and headers show only the day of the month below the label
+	m.weekdays = short
+	m.styles.DateFormat = "2"
+	for _, style := range []*gloss.Style{
+		&m.styles.LeftHeaderStyle, &m.styles.MiddleHeaderStyle, &m.styles.RightHeaderStyle,
+		&m.styles.LeftDayStyle, &m.styles.MiddleDayStyle, &m.styles.RightDayStyle,
+	} {
+		*style = borderless(*style)
+	}
+	m.styles.WeekNumberStyle = m.styles.WeekNumberStyle.PaddingTop(0)
+
+	width, height = m.styles.DateStyles.Width, m.styles.DateStyles.Height
+	if m.width > 0 {
+		width = (m.width - left) / columns
+	}
+	if fitHeight {
+		height = m.height - 2
+	}
+	m.styles.DateStyles = m.styles.DateStyles.resized(width, height)
+
+	return m
+}
+
+// Overflow sets how content and events that do not fit within a date are shown. Defaults to OverflowNone.
+func (m WeekModel) Overflow(overflow Overflow) WeekModel {
+	m.overflow = overflow
+	m.scroll = 0
+	return m
+}
+
 // Styles sets custom styling.
 func (m WeekModel) Styles(styles WeekStyles) WeekModel {
 	m.styles = styles
 	return m
 }
 
+// MinDate sets the first date that may be active. The zero time removes the minimum.
+func (m WeekModel) MinDate(date time.Time) WeekModel {
+	m.bounds.minDate = dateIn(date, m.location)
+	if date == (time.Time{}) {
+		m.bounds.minDate = time.Time{}
+	}
+	return m
+}
+
+// MaxDate sets the last date that may be active. The zero time removes the maximum.
+func (m WeekModel) MaxDate(date time.Time) WeekModel {
+	m.bounds.maxDate = dateIn(date, m.location)
+	if date == (time.Time{}) {
+		m.bounds.maxDate = time.Time{}
+	}
+	return m
+}
+
+// DisabledFunc sets a function that reports dates that may not be active, e.g. blackout days.
+//
+// Disabled dates are skipped when navigating and their headers are rendered with the disabled number style.
+func (m WeekModel) DisabledFunc(f func(date time.Time) bool) WeekModel {
+	m.bounds.disabled = f
+	return m
+}
+
+// IsDisabled determines if a date may not be active, either because it falls outside of the minimum and maximum
+// dates, because it is a skipped holiday or because it is disabled.
+func (m WeekModel) IsDisabled(date time.Time) bool {
+	return m.bounds.isDisabled(dateIn(date, m.location))
+}
+
+// DateState describes a date as it is rendered, as passed to the DateStyleFunc.
+func (m WeekModel) DateState(date time.Time) DateState {
+	date = dateIn(date, m.location)
+	today := m.Today()
+	_, isHoliday := holidayOn(m.holidays, date)
+	return DateState{
+		Active:   !m.activeDate.IsZero() && date.Equal(m.activeDate),
+		Today:    date.Equal(today),
+		Past:     date.Before(today),
+		Selected: m.selection.position(date, m.activeDate) != notSelected,
+		Disabled: m.IsDisabled(date),
+		Holiday:  isHoliday,
+	}
+}
+
+// Clock sets the source of the current time, which determines today's date. Defaults to time.Now.
+func (m WeekModel) Clock(now func() time.Time) WeekModel {
+	m.clock = now
+	return m
+}
+
+// RefreshAtMidnight enables or disables a tick, started by Init, that sends a TodayMsg at every midnight so that
+// today's date is re-rendered without any other input.
+func (m WeekModel) RefreshAtMidnight(enabled bool) WeekModel {
+	m.refreshAtMidnight = enabled
+	return m
+}
+
+// Today returns today's date, according to the clock.
+func (m WeekModel) Today() time.Time {
+	return today(m.clock, m.location)
+}
+
+// JumpToToday moves the calendar to the current week and sets today as the active date.
+//
+// If today may not be active, the nearest date in the current week that may be active is used instead.
+func (m WeekModel) JumpToToday() WeekModel {
+	// Shifting by zero weeks re-anchors a disabled date within the week
+	return m.setActiveDate(m.Today()).shiftWeeks(0)
+}
+
+// Selection sets how dates may be selected.
+func (m WeekModel) Selection(mode SelectionMode) WeekModel {
+	m.selection = selection{mode: mode}
+	return m
+}
+
+// SelectedRange returns the range of dates currently being selected. If no range is in progress, ok is false.
+func (m WeekModel) SelectedRange() (start time.Time, end time.Time, ok bool) {
+	return m.selection.rangeBounds(m.activeDate)
+}
+
+// SelectedDates returns the dates toggled on in a multi-date selection, in chronological order.
+func (m WeekModel) SelectedDates() []time.Time {
+	return m.selection.sortedDates()
+}
+
+// FocusedDate returns the date whose content receives key presses. If no content has focus, the zero time is returned.
+func (m WeekModel) FocusedDate() time.Time {
+	return m.focusedDate
+}
+
+// TimeGrid enables or disables the time grid layout.
+//
+// In the time grid layout, the left gutter shows the time of day and each day's appointments, as set with an
+// AppointmentsMsg, are placed by their start and end times. Content set with a DayContentMsg is not rendered in the
+// time grid layout.
+func (m WeekModel) TimeGrid(enabled bool) WeekModel {
+	m.showTimeGrid = enabled
+	return m
+}
+
+// TimeRange sets the time of day at which the time grid starts and ends, as offsets from midnight.
+func (m WeekModel) TimeRange(start time.Duration, end time.Duration) WeekModel {
+	m.grid.startTime = start
+	m.grid.endTime = max(start, end)
+	return m
+}
+
+// SlotDuration sets the length of each time slot in the time grid.
+func (m WeekModel) SlotDuration(d time.Duration) WeekModel {
+	if d <= 0 {
+		return m
+	}
+	m.grid.slotDuration = d
+	return m
+}
+
 // PreviousDate sets the activeDate to the previous visible date.
 //
 // Notes:
 //   - The Weekdays map is used to determine the previous date that has a day label and should therefore
 //     be visible.
-//   - If moving backwards from the first visible day, the method rolls over to the last visible day of the week.
+//   - If moving backwards from the first visible day, the method moves to the last visible day of the previous
+//     week.
 //   - If the active date is unset (initial state), this method will set the last visible weekday as the "previous"
 //     date.
+//   - Disabled dates are skipped. If there is no earlier date that may be active, the active date is unchanged.
 func (m WeekModel) PreviousDate() WeekModel {
-	ad := m.activeDate
 	if m.activeDate == (time.Time{}) {
-		ad = m.startDate
-	}
-
-	var prevWeekday time.Weekday
-	for d := 1; d < 7; d++ {
-		pw := time.Weekday((7 + int(ad.Weekday()) - d) % 7)
-		if m.weekdays.IsVisible(pw) {
-			prevWeekday = pw
-			break
+		if date, ok := m.bounds.step(m.weekdays, m.startDate.AddDate(0, 0, 7), -1); ok {
+			m = m.setActiveDate(date)
 		}
+		return m
 	}
 
-	daysDiff := int(prevWeekday) - int(ad.Weekday())
-	m.activeDate = ad.AddDate(0, 0, daysDiff)
-
-	return m
+	return m.step(-1)
 }
 
 // NextDate sets the activeDate to the next visible date.
 //
 // Notes:
 //   - The Weekdays map is used to determine the next date that has a day label and should therefore be visible.
-//   - If moving forwards from the last visible day, the method rolls over to the first visible day of the week.
+//   - If moving forwards from the last visible day, the method moves to the first visible day of the next week.
 //   - If the active date is unset (initial state), this method will set the first visible weekday as the "next"
 //     date.
+//   - Disabled dates are skipped. If there is no later date that may be active, the active date is unchanged.
 func (m WeekModel) NextDate() WeekModel {
-	ad := m.activeDate
 	if m.activeDate == (time.Time{}) {
-		ad = m.startDate
+		if date, ok := m.bounds.step(m.weekdays, m.startDate.AddDate(0, 0, -1), 1); ok {
+			m = m.setActiveDate(date)
+		}
+		return m
 	}
 
-	var nextWeekday time.Weekday
-	for d := 1; d < 7; d++ {
-		nw := time.Weekday((int(ad.Weekday()) + d) % 7)
-		if m.weekdays.IsVisible(nw) {
-			nextWeekday = nw
-			break
+	return m.step(1)
+}
+
+// step moves the active date in increments of step days, skipping dates that are hidden or disabled. If no
+// selectable date is found, the active date is unchanged.
+func (m WeekModel) step(step int) WeekModel {
+	if date, ok := m.bounds.step(m.weekdays, m.activeDate, step); ok {
+		m = m.setActiveDate(date)
+	}
+	return m
+}
+
+// PreviousWeek moves the calendar to the previous week.
+//
+// If a date is active, the active date moves to the same weekday in the previous week.
+func (m WeekModel) PreviousWeek() WeekModel {
+	return m.shiftWeeks(-1)
+}
+
+// NextWeek moves the calendar to the next week.
+//
+// If a date is active, the active date moves to the same weekday in the next week.
+func (m WeekModel) NextWeek() WeekModel {
+	return m.shiftWeeks(1)
+}
+
+// shiftWeeks moves the calendar and active date by n weeks.
+//
+// If the active date would be disabled, the nearest selectable date in the new week is used instead, preferring later
+// dates. If the new week has no selectable dates, the active date is cleared.
+func (m WeekModel) shiftWeeks(n int) WeekModel {
+	m.startDate = m.startDate.AddDate(0, 0, 7*n)
+	if m.activeDate == (time.Time{}) {
+		return m
+	}
+
+	m.activeDate = m.activeDate.AddDate(0, 0, 7*n)
+	if m.bounds.selectable(m.weekdays, m.activeDate) {
+		return m
+	}
+
+	// Days are rounded, as a week spanning a daylight saving change is an hour shorter or longer
+	offset := int(math.Round(m.activeDate.Sub(m.startDate).Hours() / 24))
+	for _, i := range nearestOffsets(offset) {
+		if date := m.startDate.AddDate(0, 0, i); m.bounds.selectable(m.weekdays, date) {
+			m.activeDate = date
+			return m
 		}
 	}
+	m.activeDate = time.Time{}
 
-	daysDiff := int(nextWeekday) - int(ad.Weekday())
-	m.activeDate = ad.AddDate(0, 0, daysDiff)
+	return m
+}
+
+// nearestOffsets orders the offsets of a week by distance from the given offset, preferring later offsets.
+func nearestOffsets(offset int) []int {
+	var offsets []int
+	for i := offset; i < 7; i++ {
+		offsets = append(offsets, i)
+	}
+	for i := offset - 1; i >= 0; i-- {
+		offsets = append(offsets, i)
+	}
+	return offsets
+}
+
+// setActiveDate sets the active date, moving the calendar to the week of the date if necessary.
+func (m WeekModel) setActiveDate(date time.Time) WeekModel {
+	m.activeDate = date
+	for m.startDate.After(date) {
+		m.startDate = m.startDate.AddDate(0, 0, -7)
+	}
+	for m.startDate.AddDate(0, 0, 6).Before(date) {
+		m.startDate = m.startDate.AddDate(0, 0, 7)
+	}
 
 	return m
 }
 
 // Init the WeekModel.
-func (m WeekModel) Init() tea.Cmd { return nil }
+func (m WeekModel) Init() tea.Cmd {
+	if m.refreshAtMidnight {
+		return midnightTick(m.id, m.clock, m.location)
+	}
+	return nil
+}
 
 // Update the WeekModel.
 func (m WeekModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
+	if msg, ok := msg.(tea.WindowSizeMsg); ok && m.fitWindow {
+		m = m.Size(msg.Width, msg.Height)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// While the content of a date has focus, it receives every key press but the one to exit it
+		var handled bool
+		var cmd tea.Cmd
+		m.focusedDate, handled, cmd = updateFocus(msg, m.keyMap, m.days, m.activeDate, m.focusedDate)
+		if handled {
+			cmds = append(cmds, cmd)
+			break
+		}
+
 		oldActiveDate := m.activeDate
+		oldStartDate := m.startDate
+
+		m.selection, cmd = m.selection.update(msg, m.keyMap, m.activeDate)
+		cmds = append(cmds, cmd)
+
 		switch {
 		case key.Matches(msg, m.keyMap.Left):
 			m = m.PreviousDate()
 		case key.Matches(msg, m.keyMap.Right):
 			m = m.NextDate()
+		case key.Matches(msg, m.keyMap.Up):
+			// If initializing the active date, assume the intent was to move into the first visible day of the week.
+			if m.activeDate == (time.Time{}) {
+				m = m.NextDate()
+				break
+			}
+			// The same weekday is always visible in other weeks, so only disabled dates are skipped
+			m = m.step(-7)
+		case key.Matches(msg, m.keyMap.Down):
+			// If initializing the active date, assume the intent was to move into the first visible day of the week.
+			if m.activeDate == (time.Time{}) {
+				m = m.NextDate()
+				break
+			}
+			// The same weekday is always visible in other weeks, so only disabled dates are skipped
+			m = m.step(7)
+		case key.Matches(msg, m.keyMap.PreviousWeek):
+			m = m.PreviousWeek()
+		case key.Matches(msg, m.keyMap.NextWeek):
+			m = m.NextWeek()
+		case key.Matches(msg, m.keyMap.Today):
+			m = m.JumpToToday()
+		case (m.overflow == OverflowScroll) && key.Matches(msg, m.keyMap.ScrollUp):
+			m.scroll = max(0, m.scroll-1)
+		case (m.overflow == OverflowScroll) && key.Matches(msg, m.keyMap.ScrollDown):
+			m.scroll = min(m.scroll+1, m.maxScroll())
 		}
 
-		if oldActiveDate != m.activeDate {
-			cmds = append(cmds, func() tea.Msg {
-				return ActiveDateMsg{
-					Date: time.Date(m.activeDate.Year(), m.activeDate.Month(), m.activeDate.Day(), 0, 0, 0, 0, time.UTC),
-				}
-			})
-		}
-	case DayContentMsg:
-		if m.startDate.Compare(msg.Date) > 0 {
-			break
-		}
-		if m.startDate.AddDate(0, 0, 6).Compare(msg.Date) < 0 {
-			break
+		// Scrolling starts over on every date
+		if !m.activeDate.Equal(oldActiveDate) {
+			m.scroll = 0
 		}
+		cmds = append(cmds, m.changed(oldStartDate, oldActiveDate)...)
+	case tea.MouseMsg:
+		oldActiveDate := m.activeDate
+		oldStartDate := m.startDate
 
-		i := time.Date(msg.Date.Year(), msg.Date.Month(), msg.Date.Day(), 0, 0, 0, 0, time.UTC)
+		var cmd tea.Cmd
+		m, cmd = m.updateMouse(msg)
+		cmds = append(cmds, cmd)
 
-		m.days[i] = msg.Content
+		if !m.activeDate.Equal(oldActiveDate) {
+			m.scroll = 0
+		}
+		// Moving to another date leaves the focused content
+		if (m.focusedDate != (time.Time{})) && !m.activeDate.Equal(m.focusedDate) {
+			cmds = append(cmds, blurred(m.focusedDate))
+			m.focusedDate = time.Time{}
+		}
+		cmds = append(cmds, m.changed(oldStartDate, oldActiveDate)...)
+	case TodayMsg:
+		if (msg.id == m.id) && m.refreshAtMidnight {
+			cmds = append(cmds, midnightTick(m.id, m.clock, m.location))
+		}
+	case DayContentMsg:
+		// Content is kept for every date so that it is still available when moving between weeks
+		m.days[dateIn(msg.Date, m.location)] = msg.Content
+	case AppointmentsMsg:
+		m.appointments[dateIn(msg.Date, m.location)] = msg.Appointments
 	default:
 		for i, d := range m.days {
 			n, cmd := d.Update(msg)
@@ -301,8 +854,117 @@ func (m WeekModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// changed reports the week and the active date, if they changed from the old week and active date.
+func (m WeekModel) changed(oldStartDate time.Time, oldActiveDate time.Time) []tea.Cmd {
+	var cmds []tea.Cmd
+	if !oldStartDate.Equal(m.startDate) {
+		start, end := m.startDate, m.startDate.AddDate(0, 0, 6)
+		cmds = append(cmds, func() tea.Msg {
+			return WeekChangedMsg{
+				Start: start,
+				End:   end,
+			}
+		})
+	}
+	// Moving to a week without selectable dates clears the active date, which is not reported
+	if !oldActiveDate.Equal(m.activeDate) && !m.activeDate.IsZero() {
+		activeDate := m.activeDate
+		cmds = append(cmds, func() tea.Msg {
+			return ActiveDateMsg{
+				Date: activeDate,
+			}
+		})
+	}
+	return cmds
+}
+
+// updateMouse handles mouse events within the view: clicking a date's header or column makes it active, double
+// clicking it selects it, and the scroll wheel moves between weeks.
+func (m WeekModel) updateMouse(msg tea.MouseMsg) (WeekModel, tea.Cmd) {
+	layout, dates := m.layout()
+	x, y := msg.X-m.offsetX, msg.Y-m.offsetY
+	if !layout.contains(x, y) {
+		return m, nil
+	}
+
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		m = m.PreviousWeek()
+	case msg.Button == tea.MouseButtonWheelDown:
+		m = m.NextWeek()
+	case isLeftClick(msg):
+		column, _, ok := layout.cell(x, y)
+		if !ok || !m.bounds.selectable(m.weekdays, dates[column]) {
+			break
+		}
+		date := dates[column]
+		m = m.setActiveDate(date)
+
+		var double bool
+		if m.clicks, double = m.clicks.click(date, m.clock()); double {
+			return m, func() tea.Msg {
+				return DateSelectedMsg{
+					Date: date,
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// layout measures the rendered headers and dates, returning the layout and the date of each column. The headers and
+// dates form a single row, so that clicking either locates the date.
+func (m WeekModel) layout() (gridLayout, []time.Time) {
+	var l gridLayout
+	var cells []string
+	if m.showTimeGrid {
+		var gutter string
+		gutter, cells = m.viewTimeGridCells()
+		l.left = gloss.Width(gutter)
+	} else {
+		cells = m.viewDateCells()
+		if m.showWeekNumbers {
+			l.left = gloss.Width(m.viewWeekNumber())
+		}
+	}
+
+	for _, cell := range cells {
+		l.columns = append(l.columns, gloss.Width(cell))
+	}
+	l.rows = []int{gloss.Height(m.ViewHeaders()) + gloss.Height(gloss.JoinHorizontal(gloss.Top, cells...))}
+
+	return l, m.visibleDates()
+}
+
+// visibleDates returns the dates of the week whose weekdays are visible.
+func (m WeekModel) visibleDates() []time.Time {
+	var dates []time.Time
+	for i := 0; i < 7; i++ {
+		day := m.startDate.AddDate(0, 0, i)
+		if m.weekdays.IsVisible(day.Weekday()) {
+			dates = append(dates, day)
+		}
+	}
+	return dates
+}
+
 // View renders the WeekModel.
 func (m WeekModel) View() string {
+	if m.showTimeGrid {
+		// Shift the headers to the right of the time gutter, unless the week number already fills the gutter
+		headers := m.ViewHeaders()
+		if !m.showWeekNumbers {
+			headers = gloss.JoinHorizontal(gloss.Top, m.styles.TimeGridStyles.TimeStyle.Render(""), headers)
+		}
+
+		return gloss.JoinVertical(
+			gloss.Left,
+			headers,
+			m.ViewTimeGrid(),
+		)
+	}
+
 	return gloss.JoinVertical(
 		gloss.Top,
 		m.ViewHeaders(),
@@ -312,10 +974,15 @@ func (m WeekModel) View() string {
 
 // ViewHeaders renders the weekday headers.
 func (m WeekModel) ViewHeaders() string {
+	m = m.fit()
+
 	first := m.weekdays.First(m.startDate)
 	last := m.weekdays.Last(m.startDate)
 
 	var headers []string
+	if m.showWeekNumbers {
+		headers = append(headers, m.viewWeekNumber())
+	}
 	for i := 0; i < 7; i++ {
 		day := m.startDate.AddDate(0, 0, i)
 		label, ok := m.weekdays.Get(day.Weekday())
@@ -336,14 +1003,27 @@ func (m WeekModel) ViewHeaders() string {
 		label = fmt.Sprintf(
 			"%s\n%s",
 			label,
-			day.Format(m.styles.DateFormat),
+			m.locale.Format(day, cmp.Or(m.styles.DateFormat, m.locale.ShortDateFormat)),
 		)
 
-		if day.Compare(m.activeDate) == 0 {
-			headerStyle := m.styles.ActiveHeaderStyle
-
-			label = headerStyle.Render(label)
+		state := m.DateState(day)
+		labelStyle := m.selection.position(day, m.activeDate).style(m.styles.DateStyles, gloss.NewStyle())
+		if state.Holiday {
+			labelStyle = m.styles.DateStyles.HolidayNumberStyle.Inherit(labelStyle)
 		}
+		if m.dateStyleFunc != nil {
+			labelStyle = m.dateStyleFunc(day, state).Inherit(labelStyle)
+		}
+		if state.Disabled {
+			labelStyle = m.styles.DateStyles.DisabledNumberStyle.Inherit(labelStyle)
+		}
+		if state.Today {
+			labelStyle = m.styles.TodayHeaderStyle.Inherit(labelStyle)
+		}
+		if state.Active {
+			labelStyle = m.styles.ActiveHeaderStyle.Inherit(labelStyle)
+		}
+		label = labelStyle.Render(label)
 
 		headers = append(headers, style.Render(label))
 	}
@@ -351,11 +1031,41 @@ func (m WeekModel) ViewHeaders() string {
 	return gloss.JoinHorizontal(gloss.Top, headers...)
 }
 
+// viewWeekNumber renders the week number label and number. In the time grid layout, it is as wide as the time gutter
+// so that the headers stay aligned with the time slots.
+func (m WeekModel) viewWeekNumber() string {
+	m = m.fit()
+
+	style := m.styles.WeekNumberStyle
+	if m.showTimeGrid {
+		style = style.Width(gloss.Width(m.styles.TimeGridStyles.TimeStyle.Render("")))
+	}
+
+	return style.Render(fmt.Sprintf(
+		"%s\n%d",
+		m.locale.WeekNumberLabel,
+		m.locale.WeekNumbering.rowWeek(m.startDate, m.startOfWeek),
+	))
+}
+
 // ViewDates renders the individual dates.
 func (m WeekModel) ViewDates() string {
-	style := m.styles.DateStyles.BodyStyle.
-		Width(m.styles.DateStyles.Width).
-		Height(m.styles.DateStyles.Height)
+	days := m.viewDateCells()
+
+	// Leave space below the week number
+	if m.showWeekNumbers {
+		days = append([]string{strings.Repeat(" ", gloss.Width(m.viewWeekNumber()))}, days...)
+	}
+
+	return gloss.JoinHorizontal(gloss.Top, days...)
+}
+
+// viewDateCells renders the bordered block of each visible date.
+func (m WeekModel) viewDateCells() []string {
+	m = m.fit()
+
+	style := m.bodyStyle()
+	events := eventsByDate(m.events, m.startDate, 7)
 
 	var days []string
 	var maxHeight int
@@ -366,10 +1076,7 @@ func (m WeekModel) ViewDates() string {
 			continue
 		}
 
-		body := style.Render("")
-		if content, ok := m.days[day]; ok {
-			body = style.Render(content.View())
-		}
+		body, _ := m.viewBody(day, events[day], style)
 
 		maxHeight = max(maxHeight, gloss.Height(body))
 
@@ -392,5 +1099,166 @@ func (m WeekModel) ViewDates() string {
 		days[i] = style.Render(days[i])
 	}
 
-	return gloss.JoinHorizontal(gloss.Top, days...)
+	return days
+}
+
+// bodyStyle sizes the body style to the date block.
+func (m WeekModel) bodyStyle() gloss.Style {
+	return m.styles.DateStyles.BodyStyle.
+		Width(m.styles.DateStyles.Width).
+		Height(m.styles.DateStyles.Height)
+}
+
+// viewBody renders the body of a date, which is the date's content if it has any, or else its holiday label and
+// events. The maximum scroll offset of the body is returned alongside it.
+func (m WeekModel) viewBody(date time.Time, events []Event, style gloss.Style) (string, int) {
+	if content, ok := m.days[date]; ok {
+		if date.Equal(m.focusedDate) {
+			style = focusedStyle(m.styles.DateStyles, style)
+		}
+		width, height := bodySize(m.styles.DateStyles, style, 0)
+		view, maxScroll := fitContent(
+			content.View(),
+			width,
+			height,
+			m.overflow,
+			itemCount(content),
+			m.scrollOf(date),
+			m.styles.DateStyles,
+		)
+		return style.Render(view), maxScroll
+	}
+	if (len(events) > 0) || (m.holidayLabel(date) != "") {
+		return m.viewEvents(date, events, style)
+	}
+	return style.Render(""), 0
+}
+
+// scrollOf returns the scroll offset of a date, which is zero except for the active date when scrolling is enabled.
+func (m WeekModel) scrollOf(date time.Time) int {
+	if (m.overflow == OverflowScroll) && date.Equal(m.activeDate) {
+		return m.scroll
+	}
+	return 0
+}
+
+// maxScroll calculates the maximum scroll offset of the active date's body.
+func (m WeekModel) maxScroll() int {
+	if m.activeDate == (time.Time{}) {
+		return 0
+	}
+
+	m = m.fit()
+	events := eventsByDate(m.events, m.startDate, 7)
+	_, maxScroll := m.viewBody(m.activeDate, events[m.activeDate], m.bodyStyle())
+	return maxScroll
+}
+
+// viewEvents renders the holiday label and the events of a day into the body of the day. The maximum scroll offset of
+// the events is returned alongside the body.
+func (m WeekModel) viewEvents(date time.Time, events []Event, style gloss.Style) (string, int) {
+	// Events are already laid out to the width of the body, so they must not be re-aligned
+	width := m.styles.DateStyles.Width - style.GetHorizontalFrameSize()
+	height := m.styles.DateStyles.Height - style.GetVerticalFrameSize()
+	var lines []string
+	if label := m.holidayLabel(date); (label != "") && (height > 0) {
+		lines = append(lines, m.styles.DateStyles.HolidayLabelStyle.Render(ansi.Truncate(label, width, "…")))
+	}
+	view, maxScroll := m.viewEventList(date, events, width, height-len(lines))
+	if view != "" {
+		lines = append(lines, view)
+	}
+	return style.Align(gloss.Left).Render(strings.Join(lines, "\n")), maxScroll
+}
+
+// viewEventList renders events into the given space. Unless overflow is left to the renderer, every event is rendered
+// so that the overflow mode decides which are shown.
+func (m WeekModel) viewEventList(date time.Time, events []Event, width int, height int) (string, int) {
+	if (len(events) == 0) || (height < 1) {
+		return "", 0
+	}
+
+	renderer := m.eventRenderer
+	if renderer == nil {
+		renderer = EventListRenderer
+	}
+	if m.overflow == OverflowNone {
+		return renderer(date, events, width, height, m.styles.DateStyles.EventStyles), 0
+	}
+
+	view := renderer(date, events, width, max(height, len(events)), m.styles.DateStyles.EventStyles)
+
+	// Events only count as items when each takes one line, and otherwise the hidden lines are counted
+	items := len(events)
+	if gloss.Height(view) != items {
+		items = 0
+	}
+	return fitContent(view, width, height, m.overflow, items, m.scrollOf(date), m.styles.DateStyles)
+}
+
+// holidayLabel provides the name of the holiday on a date if holiday labels are enabled, or else an empty string.
+func (m WeekModel) holidayLabel(date time.Time) string {
+	if !m.showHolidayLabels {
+		return ""
+	}
+	h, _ := m.Holiday(date)
+	return h.Name
+}
+
+// ViewTimeGrid renders the individual dates as columns of time slots, with the time of day in the left gutter.
+func (m WeekModel) ViewTimeGrid() string {
+	gutter, columns := m.viewTimeGridCells()
+
+	return gloss.JoinHorizontal(gloss.Top, append([]string{gutter}, columns...)...)
+}
+
+// viewTimeGridCells renders the time gutter and the column of time slots of each visible date.
+func (m WeekModel) viewTimeGridCells() (string, []string) {
+	m = m.fit()
+
+	styles := m.styles.TimeGridStyles
+	slots := m.grid.slots()
+	dates := m.visibleDates()
+
+	events := eventsByDate(m.events, m.startDate, 7)
+
+	var columns []string
+	for i, day := range dates {
+		// Figure out if the border style is left, middle, or right
+		style := m.styles.MiddleDayStyle
+		switch i {
+		case 0:
+			style = m.styles.LeftDayStyle
+		case len(dates) - 1:
+			style = m.styles.RightDayStyle
+		}
+		// Time slots are already laid out to the full width, so they must not be re-aligned
+		style = style.Width(m.styles.DateStyles.Width).Align(gloss.Left)
+
+		// Timed events are placed alongside the day's appointments
+		appointments := slices.Clone(m.appointments[day])
+		for _, e := range events[day] {
+			if !e.AllDay {
+				appointments = append(appointments, e.appointment())
+			}
+		}
+
+		lines := m.grid.viewColumn(
+			day,
+			appointments,
+			m.styles.DateStyles.Width,
+			0,
+			slots,
+			-1,
+			styles,
+		)
+		columns = append(columns, style.Render(strings.Join(lines, "\n")))
+	}
+
+	// Align the time labels with the first time slot, below any border and padding of the date block
+	gutter := m.grid.viewGutter(normalizeDate(m.startDate), 0, slots, -1, styles)
+	top := m.styles.LeftDayStyle.GetBorderTopSize() + m.styles.LeftDayStyle.GetPaddingTop()
+	gutter = append(make([]string, top), gutter...)
+
+	return strings.Join(gutter, "\n"), columns
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/exp/golden"
 	"github.com/stretchr/testify/assert"
@@ -887,14 +888,14 @@ func TestWeekModel_PreviousDate(t *testing.T) {
 			startOfWeek:    time.Tuesday,
 			weekdays:       Weekdays{time.Monday: "", time.Tuesday: "", time.Wednesday: ""},
 			activeDate:     time.Time{},
-			wantActiveDate: newDate(2024, time.September, 23),
+			wantActiveDate: newDate(2024, time.September, 30),
 		},
 		{
 			name:           "inital-tuesday-prev-sunday",
 			startOfWeek:    time.Tuesday,
 			weekdays:       Weekdays{time.Sunday: "", time.Tuesday: "", time.Wednesday: ""},
 			activeDate:     time.Time{},
-			wantActiveDate: newDate(2024, time.September, 22),
+			wantActiveDate: newDate(2024, time.September, 29),
 		},
 		{
 			name:           "inital-tuesday-prev-wednesday",
@@ -908,14 +909,14 @@ func TestWeekModel_PreviousDate(t *testing.T) {
 			startOfWeek:    time.Sunday,
 			weekdays:       Weekdays{time.Tuesday: "", time.Wednesday: ""},
 			activeDate:     newDate(2024, time.September, 24),
-			wantActiveDate: newDate(2024, time.September, 25),
+			wantActiveDate: newDate(2024, time.September, 18),
 		},
 		{
 			name:           "sunday-prev-friday",
 			startOfWeek:    time.Sunday,
 			weekdays:       Weekdays{time.Tuesday: "", time.Wednesday: "", time.Friday: ""},
 			activeDate:     newDate(2024, time.September, 22),
-			wantActiveDate: newDate(2024, time.September, 27),
+			wantActiveDate: newDate(2024, time.September, 20),
 		},
 		{
 			name:           "friday-prev-sunday",
@@ -962,28 +963,28 @@ func TestWeekModel_NextDate(t *testing.T) {
 		{
 			name:           "inital-sunday-next-monday",
 			startOfWeek:    time.Sunday,
-			weekdays:       Weekdays{time.Sunday: "", time.Monday: "", time.Wednesday: ""},
+			weekdays:       Weekdays{time.Monday: "", time.Wednesday: ""},
 			activeDate:     time.Time{},
 			wantActiveDate: newDate(2024, time.September, 23),
 		},
 		{
 			name:           "inital-sunday-next-wednesday",
 			startOfWeek:    time.Sunday,
-			weekdays:       Weekdays{time.Sunday: "", time.Wednesday: "", time.Friday: ""},
+			weekdays:       Weekdays{time.Wednesday: "", time.Friday: ""},
 			activeDate:     time.Time{},
 			wantActiveDate: newDate(2024, time.September, 25),
 		},
 		{
 			name:           "inital-tuesday-next-wednesday",
 			startOfWeek:    time.Tuesday,
-			weekdays:       Weekdays{time.Monday: "", time.Tuesday: "", time.Wednesday: ""},
+			weekdays:       Weekdays{time.Monday: "", time.Wednesday: ""},
 			activeDate:     time.Time{},
 			wantActiveDate: newDate(2024, time.September, 25),
 		},
 		{
 			name:           "inital-tuesday-next-saturday",
 			startOfWeek:    time.Tuesday,
-			weekdays:       Weekdays{time.Sunday: "", time.Tuesday: "", time.Saturday: ""},
+			weekdays:       Weekdays{time.Sunday: "", time.Saturday: ""},
 			activeDate:     time.Time{},
 			wantActiveDate: newDate(2024, time.September, 28),
 		},
@@ -992,7 +993,7 @@ func TestWeekModel_NextDate(t *testing.T) {
 			startOfWeek:    time.Saturday,
 			weekdays:       Weekdays{time.Sunday: "", time.Wednesday: ""},
 			activeDate:     time.Time{},
-			wantActiveDate: newDate(2024, time.September, 22),
+			wantActiveDate: newDate(2024, time.September, 29),
 		},
 		{
 			name:           "tuesday-next-wednesday",
@@ -1006,14 +1007,14 @@ func TestWeekModel_NextDate(t *testing.T) {
 			startOfWeek:    time.Sunday,
 			weekdays:       Weekdays{time.Tuesday: "", time.Wednesday: ""},
 			activeDate:     newDate(2024, time.September, 25),
-			wantActiveDate: newDate(2024, time.September, 24),
+			wantActiveDate: newDate(2024, time.October, 1),
 		},
 		{
 			name:           "saturday-next-sunday",
 			startOfWeek:    time.Sunday,
 			weekdays:       Weekdays{time.Sunday: "", time.Friday: "", time.Saturday: ""},
 			activeDate:     newDate(2024, time.September, 28),
-			wantActiveDate: newDate(2024, time.September, 22),
+			wantActiveDate: newDate(2024, time.September, 29),
 		},
 	}
 
@@ -1103,8 +1104,8 @@ func TestWeekModel_Update(t *testing.T) {
 		{
 			name:           "keymsg-right",
 			msg:            tea.KeyMsg{Type: tea.KeyRight},
-			wantActiveDate: newDate(2024, time.September, 23),
-			wantCmd:        func() tea.Msg { return ActiveDateMsg{Date: newDate(2024, time.September, 23)} },
+			wantActiveDate: newDate(2024, time.September, 22),
+			wantCmd:        func() tea.Msg { return ActiveDateMsg{Date: newDate(2024, time.September, 22)} },
 		},
 		{
 			name:           "keymsg-left",
@@ -1179,3 +1180,280 @@ func TestWeekModel_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestWeekModel_Update_Paging(t *testing.T) {
+	tests := []struct {
+		name           string
+		activeDate     time.Time
+		msgs           []tea.Msg
+		wantStartDate  time.Time
+		wantActiveDate time.Time
+		wantMsgs       []tea.Msg
+	}{
+		{
+			name: "next-week",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantStartDate:  newDate(2024, time.September, 29),
+			wantActiveDate: time.Time{},
+			wantMsgs: []tea.Msg{
+				WeekChangedMsg{Start: newDate(2024, time.September, 29), End: newDate(2024, time.October, 5)},
+			},
+		},
+		{
+			name:       "previous-week-active",
+			activeDate: newDate(2024, time.September, 24),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgUp},
+			},
+			wantStartDate:  newDate(2024, time.September, 15),
+			wantActiveDate: newDate(2024, time.September, 17),
+			wantMsgs: []tea.Msg{
+				WeekChangedMsg{Start: newDate(2024, time.September, 15), End: newDate(2024, time.September, 21)},
+				ActiveDateMsg{Date: newDate(2024, time.September, 17)},
+			},
+		},
+		{
+			name:       "up",
+			activeDate: newDate(2024, time.September, 24),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyUp},
+			},
+			wantStartDate:  newDate(2024, time.September, 15),
+			wantActiveDate: newDate(2024, time.September, 17),
+			wantMsgs: []tea.Msg{
+				WeekChangedMsg{Start: newDate(2024, time.September, 15), End: newDate(2024, time.September, 21)},
+				ActiveDateMsg{Date: newDate(2024, time.September, 17)},
+			},
+		},
+		{
+			name: "down-uninitialized",
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+			},
+			wantStartDate:  newDate(2024, time.September, 22),
+			wantActiveDate: newDate(2024, time.September, 22),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.September, 22)},
+			},
+		},
+		{
+			name:       "right-past-last-day",
+			activeDate: newDate(2024, time.September, 28),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+				tea.KeyMsg{Type: tea.KeyLeft},
+			},
+			wantStartDate:  newDate(2024, time.September, 22),
+			wantActiveDate: newDate(2024, time.September, 28),
+			wantMsgs: []tea.Msg{
+				WeekChangedMsg{Start: newDate(2024, time.September, 29), End: newDate(2024, time.October, 5)},
+				ActiveDateMsg{Date: newDate(2024, time.September, 29)},
+				WeekChangedMsg{Start: newDate(2024, time.September, 22), End: newDate(2024, time.September, 28)},
+				ActiveDateMsg{Date: newDate(2024, time.September, 28)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewWeek(newDate(2024, time.September, 24))
+			tm.activeDate = tt.activeDate
+			_ = tm.Init()
+
+			// Test
+			var gotMsgs []tea.Msg
+			for _, msg := range tt.msgs {
+				gotModel, gotCmd := tm.Update(msg)
+				tm = gotModel.(WeekModel)
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+			}
+
+			// Assertions
+			assert.Equal(t, tt.wantStartDate, tm.startDate)
+			assert.Equal(t, tt.wantActiveDate, tm.activeDate)
+			assert.Equal(t, tt.wantMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestWeekModel_Update_DayContent(t *testing.T) {
+	// Setup
+	tm := NewWeek(newDate(2024, time.September, 24))
+
+	// Test
+	got, _ := tm.Update(DayContentMsg{
+		Date:    newDate(2024, time.October, 1),
+		Content: testDayModel{},
+	})
+	got = got.(WeekModel).NextWeek()
+
+	// Assertions
+	assert.Contains(t, got.(WeekModel).days, newDate(2024, time.October, 1))
+}
+
+func TestWeekModel_Location(t *testing.T) {
+	// Setup
+	loc := mustLoadLocation("Asia/Tokyo")
+	tm := NewWeek(newDate(2024, time.September, 24)).
+		Location(loc)
+
+	// Test
+	got, _ := tm.Update(DayContentMsg{
+		// 8pm UTC on September 24th is already September 25th in Tokyo
+		Date:    time.Date(2024, time.September, 24, 20, 0, 0, 0, time.UTC),
+		Content: testDayModel{},
+	})
+	got, gotCmd := got.(WeekModel).Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	// Assertions
+	assert.Equal(t, time.Date(2024, time.September, 22, 0, 0, 0, 0, loc), got.(WeekModel).startDate)
+	assert.Contains(t, got.(WeekModel).days, time.Date(2024, time.September, 25, 0, 0, 0, 0, loc))
+	assert.Equal(t, []tea.Msg{
+		ActiveDateMsg{Date: time.Date(2024, time.September, 22, 0, 0, 0, 0, loc)},
+	}, collectMsgs(gotCmd))
+}
+
+func TestWeekModel_changed_SameInstant(t *testing.T) {
+	// Setup
+	loc := mustLoadLocation("Asia/Tokyo")
+	tm := NewWeek(newDate(2024, time.September, 24)).
+		Location(loc)
+	tm = tm.setActiveDate(time.Date(2024, time.September, 24, 0, 0, 0, 0, loc))
+
+	// Test
+	got := tm.changed(tm.startDate, tm.activeDate.In(time.UTC))
+
+	// Assertions
+	assert.Empty(t, got)
+}
+
+func TestWeekModel_Location_DaylightSaving(t *testing.T) {
+	// Setup
+	loc := mustLoadLocation("America/New_York")
+	tm := NewWeek(newDate(2024, time.March, 6)).
+		Location(loc).
+		DisabledFunc(func(date time.Time) bool { return date.Day() == 13 })
+	tm = tm.setActiveDate(time.Date(2024, time.March, 6, 0, 0, 0, 0, loc))
+
+	// Test
+	got := tm.NextWeek()
+
+	// Assertions
+	assert.Equal(t, time.Date(2024, time.March, 10, 0, 0, 0, 0, loc), got.startDate)
+	assert.Equal(t, time.Date(2024, time.March, 14, 0, 0, 0, 0, loc), got.activeDate)
+}
+
+func TestWeekModel_View_TimeGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		weekdays Weekdays
+	}{
+		{
+			name:     "seven-day-week",
+			weekdays: DefaultWeekdays(),
+		},
+		{
+			name: "three-day-week",
+			weekdays: Weekdays{
+				time.Monday:    "Mon",
+				time.Tuesday:   "Tue",
+				time.Wednesday: "Wed",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewWeek(newDate(2024, time.October, 1)).
+				Weekdays(tt.weekdays).
+				TimeGrid(true).
+				TimeRange(8*time.Hour, 12*time.Hour)
+			_ = tm.Init()
+
+			msgs := []tea.Msg{
+				AppointmentsMsg{
+					Date: newDate(2024, time.September, 30),
+					Appointments: []Appointment{
+						{Title: "Lunch", Start: newTime(2024, time.September, 30, 11, 0), End: newTime(2024, time.September, 30, 12, 0)},
+					},
+				},
+				AppointmentsMsg{
+					Date: newDate(2024, time.October, 1),
+					Appointments: []Appointment{
+						{Title: "Core Hours", Start: newTime(2024, time.October, 1, 9, 0), End: newTime(2024, time.October, 1, 10, 30)},
+						{Title: "Standup", Start: newTime(2024, time.October, 1, 9, 15), End: newTime(2024, time.October, 1, 9, 45)},
+						{Title: "Sync", Start: newTime(2024, time.October, 1, 10, 0), End: newTime(2024, time.October, 1, 11, 0)},
+					},
+				},
+			}
+			for _, msg := range msgs {
+				n, _ := tm.Update(msg)
+				tm = n.(WeekModel)
+			}
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestWeekModel_View_TimeGrid_DaylightSaving(t *testing.T) {
+	// Setup
+	loc := mustLoadLocation("America/New_York")
+	tm := NewWeek(newDate(2024, time.March, 10)).
+		Location(loc).
+		Weekdays(Weekdays{time.Saturday: "Sat", time.Sunday: "Sun", time.Monday: "Mon"}).
+		TimeGrid(true).
+		TimeRange(8*time.Hour, 11*time.Hour)
+	_ = tm.Init()
+	// Daylight saving time begins on the Sunday, but not on the Saturday
+	for _, day := range []int{10, 16} {
+		n, _ := tm.Update(AppointmentsMsg{
+			Date: time.Date(2024, time.March, day, 0, 0, 0, 0, loc),
+			Appointments: []Appointment{
+				{
+					Title: "Brunch",
+					Start: time.Date(2024, time.March, day, 9, 0, 0, 0, loc),
+					End:   time.Date(2024, time.March, day, 10, 0, 0, 0, loc),
+				},
+			},
+		})
+		tm = n.(WeekModel)
+	}
+
+	// Test
+	got := ansi.Strip(tm.View())
+
+	// Assertions
+	golden.RequireEqual(t, []byte(got))
+}
+
+func TestWeekModel_DateStyleFunc(t *testing.T) {
+	// Setup
+	gotStates := make(map[time.Time]DateState)
+	tm := NewWeek(newDate(2024, time.September, 24)).
+		Clock(fixedClock(newTime(2024, time.September, 24, 8, 0))).
+		MaxDate(newDate(2024, time.September, 27)).
+		DateStyleFunc(func(date time.Time, state DateState) gloss.Style {
+			gotStates[date] = state
+			return gloss.NewStyle().Underline(true)
+		})
+	tm.activeDate = newDate(2024, time.September, 25)
+
+	// Test
+	_ = tm.ViewHeaders()
+
+	// Assertions
+	assert.Len(t, gotStates, 7)
+	assert.Equal(t, DateState{Past: true}, gotStates[newDate(2024, time.September, 22)])
+	assert.Equal(t, DateState{Today: true}, gotStates[newDate(2024, time.September, 24)])
+	assert.Equal(t, DateState{Active: true}, gotStates[newDate(2024, time.September, 25)])
+	assert.Equal(t, DateState{Disabled: true}, gotStates[newDate(2024, time.September, 28)])
+}
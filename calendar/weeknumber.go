@@ -0,0 +1,43 @@
+package calendar
+
+import (
+	"time"
+)
+
+// WeekNumbering determines how the weeks of a year are numbered.
+type WeekNumbering int
+
+const (
+	// WeekNumberingISO numbers weeks as ISO 8601 does: weeks begin on Monday and week 1 is the week containing the
+	// first Thursday of the year, so a few days at either end of a year may belong to a week of the adjacent year.
+	WeekNumberingISO WeekNumbering = iota
+
+	// WeekNumberingJanuaryFirst numbers weeks from the week containing January 1st, with weeks beginning on the first
+	// day of the week, as is common in the US.
+	WeekNumberingJanuaryFirst
+)
+
+// Week calculates the number of the week containing the date.
+//
+// The first day of the week is only used by numberings whose weeks do not have a fixed first day.
+func (n WeekNumbering) Week(date time.Time, startOfWeek time.Weekday) int {
+	if n == WeekNumberingISO {
+		_, week := date.ISOWeek()
+		return week
+	}
+
+	// Weeks spanning the new year belong to the new year
+	start := StartOfWeekContaining(sameDateIn(date, time.UTC), startOfWeek)
+	newYear := time.Date(start.AddDate(0, 0, 6).Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	firstStart := StartOfWeekContaining(newYear, startOfWeek)
+
+	return int(start.Sub(firstStart).Hours()/(24*7)) + 1
+}
+
+// rowWeek calculates the number of a calendar row of seven days, given the first date of the row.
+//
+// The middle of the row is used so that a row whose first day of the week differs from the numbering's, e.g. a row
+// beginning on Sunday with ISO numbering, is numbered after the week most of its days belong to.
+func (n WeekNumbering) rowWeek(rowStart time.Time, startOfWeek time.Weekday) int {
+	return n.Week(rowStart.AddDate(0, 0, 3), startOfWeek)
+}
@@ -0,0 +1,157 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekNumbering_Week(t *testing.T) {
+	tests := []struct {
+		name        string
+		numbering   WeekNumbering
+		date        time.Time
+		startOfWeek time.Weekday
+		want        int
+	}{
+		{
+			name:      "iso",
+			numbering: WeekNumberingISO,
+			date:      newDate(2024, time.September, 10),
+			want:      37,
+		},
+		{
+			name:      "iso-previous-year",
+			numbering: WeekNumberingISO,
+			date:      newDate(2021, time.January, 3),
+			want:      53,
+		},
+		{
+			name:      "iso-next-year",
+			numbering: WeekNumberingISO,
+			date:      newDate(2024, time.December, 30),
+			want:      1,
+		},
+		{
+			name:        "january-first",
+			numbering:   WeekNumberingJanuaryFirst,
+			date:        newDate(2024, time.September, 10),
+			startOfWeek: time.Sunday,
+			want:        37,
+		},
+		{
+			name:        "january-first-next-year",
+			numbering:   WeekNumberingJanuaryFirst,
+			date:        newDate(2024, time.December, 29),
+			startOfWeek: time.Sunday,
+			want:        1,
+		},
+		{
+			name:        "january-first-last-week",
+			numbering:   WeekNumberingJanuaryFirst,
+			date:        newDate(2024, time.December, 28),
+			startOfWeek: time.Sunday,
+			want:        52,
+		},
+		{
+			name:        "january-first-monday",
+			numbering:   WeekNumberingJanuaryFirst,
+			date:        newDate(2023, time.January, 1),
+			startOfWeek: time.Monday,
+			want:        1,
+		},
+		{
+			name:        "january-first-monday-second-week",
+			numbering:   WeekNumberingJanuaryFirst,
+			date:        newDate(2023, time.January, 2),
+			startOfWeek: time.Monday,
+			want:        2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test
+			got := tt.numbering.Week(tt.date, tt.startOfWeek)
+
+			// Assertions
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWeekNumbering_rowWeek(t *testing.T) {
+	// Setup
+	rowStart := newDate(2024, time.September, 8)
+
+	// Test
+	got := WeekNumberingISO.rowWeek(rowStart, time.Sunday)
+
+	// Assertions
+	assert.Equal(t, 37, got)
+}
+
+func TestMonthModel_View_WeekNumbers(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale Locale
+	}{
+		{
+			name:   "en-US",
+			locale: LocaleEnUS(),
+		},
+		{
+			name:   "de",
+			locale: LocaleDe(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewMonth(2024, time.December).
+				Locale(tt.locale).
+				WeekNumbers(true)
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestWeekModel_View_WeekNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeGrid bool
+	}{
+		{
+			name: "dates",
+		},
+		{
+			name:     "time-grid",
+			timeGrid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewWeek(newDate(2024, time.December, 31)).
+				TimeGrid(tt.timeGrid).
+				TimeRange(9*time.Hour, 11*time.Hour).
+				WeekNumbers(true)
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
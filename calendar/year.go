@@ -0,0 +1,337 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+)
+
+// YearChangedMsg notifies to other models that the YearModel now represents a different year.
+type YearChangedMsg struct {
+	// Year now represented
+	Year int
+}
+
+// YearModel represents a full calendar year as a grid of compact months.
+type YearModel struct {
+	// keyMap is key bindings for calendar navigation
+	keyMap KeyMap
+
+	// startOfWeek is the day that represents the beginning of the week
+	startOfWeek time.Weekday
+
+	// weekdays manages labels for weekdays
+	weekdays Weekdays
+
+	// locale provides month names
+	locale Locale
+
+	// year to represent
+	year int
+
+	// columns is the number of months rendered side-by-side
+	columns int
+
+	activeDate time.Time
+
+	// dateStyleFunc provides optional per-date styling
+	dateStyleFunc DateStyleFunc
+
+	// Styles
+	styles YearStyles
+}
+
+// NewYear creates a new YearModel.
+func NewYear(year int) YearModel {
+	m := YearModel{
+		keyMap: DefaultYearKeyMap(),
+
+		year: year,
+
+		startOfWeek: time.Sunday,
+		weekdays:    DefaultWeekdaysShort(),
+		locale:      DefaultLocale(),
+
+		columns: 3,
+
+		styles: DefaultYearStyles(),
+	}
+
+	return m
+}
+
+// StartOfWeek sets the first day of a week.
+func (m YearModel) StartOfWeek(weekday time.Weekday) YearModel {
+	m.startOfWeek = weekday
+	return m
+}
+
+// Weekdays sets custom weekday labels.
+func (m YearModel) Weekdays(weekdays Weekdays) YearModel {
+	m.weekdays = weekdays
+	return m
+}
+
+// Locale sets the month names, weekday labels and first day of the week.
+//
+// The locale's narrow weekday labels are used to keep months compact. Weekday labels and the first day of the week may
+// still be overridden afterwards with Weekdays and StartOfWeek.
+func (m YearModel) Locale(locale Locale) YearModel {
+	m.locale = locale
+	m.weekdays = locale.ShortWeekdays
+	m.startOfWeek = locale.FirstDayOfWeek
+	return m
+}
+
+// Columns sets the number of months rendered side-by-side, e.g. 3 for a 3x4 grid or 6 for a 6x2 grid.
+func (m YearModel) Columns(columns int) YearModel {
+	m.columns = max(1, min(12, columns))
+	return m
+}
+
+// DateStyleFunc sets a function that provides styling for individual dates. Only the Active state is set, as a year has
+// no notion of today, selection or bounds.
+//
+// The returned style is applied on top of the number style, so only the properties that should differ need to be
+// set. The active date style always takes precedence.
+func (m YearModel) DateStyleFunc(f DateStyleFunc) YearModel {
+	m.dateStyleFunc = f
+	return m
+}
+
+// Styles sets custom styling.
+func (m YearModel) Styles(styles YearStyles) YearModel {
+	m.styles = styles
+	return m
+}
+
+// ActiveDate returns the active date. If no date is active, the zero time is returned.
+func (m YearModel) ActiveDate() time.Time {
+	return m.activeDate
+}
+
+// PreviousMonth moves the active date to the previous month.
+//
+// The active date is kept as close as possible to its current day of the month.
+func (m YearModel) PreviousMonth() YearModel {
+	return m.shiftMonths(-1)
+}
+
+// NextMonth moves the active date to the next month.
+//
+// The active date is kept as close as possible to its current day of the month.
+func (m YearModel) NextMonth() YearModel {
+	return m.shiftMonths(1)
+}
+
+// PreviousYear moves the calendar to the previous year.
+//
+// If a date is active, the active date moves to the same month in the previous year.
+func (m YearModel) PreviousYear() YearModel {
+	if m.activeDate == (time.Time{}) {
+		m.year -= 1
+		return m
+	}
+	return m.shiftMonths(-12)
+}
+
+// NextYear moves the calendar to the next year.
+//
+// If a date is active, the active date moves to the same month in the next year.
+func (m YearModel) NextYear() YearModel {
+	if m.activeDate == (time.Time{}) {
+		m.year += 1
+		return m
+	}
+	return m.shiftMonths(12)
+}
+
+// shiftMonths moves the active date by n months and re-anchors it to a visible day in the new month.
+func (m YearModel) shiftMonths(n int) YearModel {
+	if m.activeDate == (time.Time{}) {
+		return m
+	}
+
+	first := time.Date(m.activeDate.Year(), m.activeDate.Month()+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	day := min(m.activeDate.Day(), DaysInMonth(first.Year(), first.Month()))
+	day = nearestVisibleDay(m.weekdays, first.Year(), first.Month(), day)
+	if day == 0 {
+		return m
+	}
+
+	return m.setActiveDate(time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC))
+}
+
+// setActiveDate sets the active date, moving the calendar to the year of the date if necessary.
+func (m YearModel) setActiveDate(date time.Time) YearModel {
+	m.activeDate = date
+	m.year = date.Year()
+
+	return m
+}
+
+// Init the YearModel.
+func (m YearModel) Init() tea.Cmd { return nil }
+
+// Update the YearModel.
+func (m YearModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		oldActiveDate := m.activeDate
+		oldYear := m.year
+
+		// If initializing the active date, start from either edge of the year so that the first movement lands on
+		// the first or last visible day of the year.
+		firstDate := time.Date(m.year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		lastDate := time.Date(m.year, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+		switch {
+		case key.Matches(msg, m.keyMap.Left), key.Matches(msg, m.keyMap.Up):
+			if m.activeDate == (time.Time{}) {
+				m.activeDate = visibleDate(m.weekdays, lastDate.AddDate(0, 0, 1), -1)
+				break
+			}
+			if key.Matches(msg, m.keyMap.Up) {
+				m = m.setActiveDate(m.activeDate.AddDate(0, 0, -7))
+				break
+			}
+			m = m.setActiveDate(visibleDate(m.weekdays, m.activeDate, -1))
+		case key.Matches(msg, m.keyMap.Right), key.Matches(msg, m.keyMap.Down):
+			if m.activeDate == (time.Time{}) {
+				m.activeDate = visibleDate(m.weekdays, firstDate.AddDate(0, 0, -1), 1)
+				break
+			}
+			if key.Matches(msg, m.keyMap.Down) {
+				m = m.setActiveDate(m.activeDate.AddDate(0, 0, 7))
+				break
+			}
+			m = m.setActiveDate(visibleDate(m.weekdays, m.activeDate, 1))
+		case key.Matches(msg, m.keyMap.PreviousMonth):
+			m = m.PreviousMonth()
+		case key.Matches(msg, m.keyMap.NextMonth):
+			m = m.NextMonth()
+		case key.Matches(msg, m.keyMap.PreviousYear):
+			m = m.PreviousYear()
+		case key.Matches(msg, m.keyMap.NextYear):
+			m = m.NextYear()
+		}
+
+		if oldYear != m.year {
+			year := m.year
+			cmds = append(cmds, func() tea.Msg {
+				return YearChangedMsg{
+					Year: year,
+				}
+			})
+		}
+		if !oldActiveDate.Equal(m.activeDate) {
+			activeDate := m.activeDate
+			cmds = append(cmds, func() tea.Msg {
+				return ActiveDateMsg{
+					Date: activeDate,
+				}
+			})
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// View renders the YearModel.
+func (m YearModel) View() string {
+	var rows []string
+	var row []string
+	for month := time.January; month <= time.December; month++ {
+		row = append(row, m.styles.MonthStyle.Render(m.ViewMonth(month)))
+
+		if len(row) == m.columns {
+			rows = append(rows, gloss.JoinHorizontal(gloss.Top, row...))
+			row = nil
+		}
+	}
+	if len(row) != 0 {
+		rows = append(rows, gloss.JoinHorizontal(gloss.Top, row...))
+	}
+
+	return gloss.JoinVertical(gloss.Left, rows...)
+}
+
+// ViewMonth renders a single compact month, including its title and weekday headers.
+//
+// Every month is rendered with six weeks so that months line up when placed side-by-side.
+func (m YearModel) ViewMonth(month time.Month) string {
+	startDate := StartOfWeekContaining(time.Date(m.year, month, 1, 0, 0, 0, 0, time.UTC), m.startOfWeek)
+
+	var headers []string
+	for i := 0; i < 7; i++ {
+		label, ok := m.weekdays.Get(startDate.AddDate(0, 0, i).Weekday())
+		if !ok {
+			continue
+		}
+		headers = append(headers, m.styles.HeaderStyle.Width(m.styles.CellWidth).Render(label))
+	}
+	header := strings.Join(headers, "")
+	width := gloss.Width(header)
+
+	lines := []string{
+		m.styles.TitleStyle.Width(width).Render(m.locale.MonthName(month)),
+		header,
+	}
+
+	rows := CalendarRowsInMonth(m.year, month, m.startOfWeek)
+	for w := 0; w < rows; w++ {
+		var cells []string
+		inMonth := false
+		for i := 0; i < 7; i++ {
+			date := startDate.AddDate(0, 0, (7*w)+i)
+			if !m.weekdays.IsVisible(date.Weekday()) {
+				continue
+			}
+
+			if date.Month() != month {
+				cells = append(cells, strings.Repeat(" ", m.styles.CellWidth))
+				continue
+			}
+			inMonth = true
+			cells = append(cells, m.ViewDate(date))
+		}
+
+		// Skip weeks whose visible days all fall outside the month
+		if !inMonth {
+			continue
+		}
+		lines = append(lines, strings.Join(cells, ""))
+	}
+
+	// Pad to a consistent number of weeks
+	for len(lines) < 8 {
+		lines = append(lines, strings.Repeat(" ", width))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ViewDate renders a single date cell.
+func (m YearModel) ViewDate(date time.Time) string {
+	active := date.Equal(m.activeDate)
+	style := m.styles.NumberStyle
+	if m.dateStyleFunc != nil {
+		style = m.dateStyleFunc(date, DateState{Active: active}).Inherit(style)
+	}
+	if active {
+		style = m.styles.ActiveNumberStyle.Inherit(style)
+	}
+	style = style.Width(m.styles.CellWidth)
+
+	return style.Render(fmt.Sprintf("%d", date.Day()))
+}
+
+// Title generates a title for the calendar that may be used during rendering.
+func (m YearModel) Title() string {
+	return fmt.Sprintf("%d", m.year)
+}
@@ -0,0 +1,282 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewYear(t *testing.T) {
+	// Test
+	got := NewYear(2024)
+
+	// Assertions
+	assert.Equal(t, 2024, got.year)
+	assert.Equal(t, time.Sunday, got.startOfWeek)
+	assert.Equal(t, DefaultWeekdaysShort(), got.weekdays)
+	assert.Equal(t, 3, got.columns)
+	assert.Zero(t, got.activeDate)
+}
+
+func TestYearModel_Columns(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns int
+		want    int
+	}{
+		{
+			name:    "valid",
+			columns: 4,
+			want:    4,
+		},
+		{
+			name:    "too-small",
+			columns: 0,
+			want:    1,
+		},
+		{
+			name:    "too-large",
+			columns: 13,
+			want:    12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewYear(2024)
+
+			// Test
+			got := tm.Columns(tt.columns)
+
+			// Assertions
+			assert.Equal(t, tt.want, got.columns)
+		})
+	}
+}
+
+func TestYearModel_Update(t *testing.T) {
+	tests := []struct {
+		name           string
+		model          YearModel
+		msgs           []tea.Msg
+		wantYear       int
+		wantActiveDate time.Time
+		wantMsgs       []tea.Msg
+	}{
+		{
+			name:  "first-right",
+			model: NewYear(2024),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+			},
+			wantYear:       2024,
+			wantActiveDate: newDate(2024, time.January, 1),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.January, 1)},
+			},
+		},
+		{
+			name:  "first-left",
+			model: NewYear(2024),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyLeft},
+			},
+			wantYear:       2024,
+			wantActiveDate: newDate(2024, time.December, 31),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.December, 31)},
+			},
+		},
+		{
+			name: "across-months",
+			model: func() YearModel {
+				m := NewYear(2024)
+				m.activeDate = newDate(2024, time.January, 29)
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyDown},
+				tea.KeyMsg{Type: tea.KeyRight},
+			},
+			wantYear:       2024,
+			wantActiveDate: newDate(2024, time.February, 6),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.February, 5)},
+				ActiveDateMsg{Date: newDate(2024, time.February, 6)},
+			},
+		},
+		{
+			name: "across-years",
+			model: func() YearModel {
+				m := NewYear(2024)
+				m.activeDate = newDate(2024, time.December, 31)
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+			},
+			wantYear:       2025,
+			wantActiveDate: newDate(2025, time.January, 1),
+			wantMsgs: []tea.Msg{
+				YearChangedMsg{Year: 2025},
+				ActiveDateMsg{Date: newDate(2025, time.January, 1)},
+			},
+		},
+		{
+			name: "hidden-weekdays",
+			model: func() YearModel {
+				m := NewYear(2024).Weekdays(Weekdays{
+					time.Monday:    "M",
+					time.Tuesday:   "T",
+					time.Wednesday: "W",
+					time.Thursday:  "R",
+					time.Friday:    "F",
+				})
+				m.activeDate = newDate(2024, time.March, 1)
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRight},
+			},
+			wantYear:       2024,
+			wantActiveDate: newDate(2024, time.March, 4),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.March, 4)},
+			},
+		},
+		{
+			name: "next-month",
+			model: func() YearModel {
+				m := NewYear(2024)
+				m.activeDate = newDate(2024, time.January, 31)
+				return m
+			}(),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantYear:       2024,
+			wantActiveDate: newDate(2024, time.February, 29),
+			wantMsgs: []tea.Msg{
+				ActiveDateMsg{Date: newDate(2024, time.February, 29)},
+			},
+		},
+		{
+			name:  "previous-year-uninitialized",
+			model: NewYear(2024),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyCtrlPgUp},
+			},
+			wantYear:       2023,
+			wantActiveDate: time.Time{},
+			wantMsgs: []tea.Msg{
+				YearChangedMsg{Year: 2023},
+			},
+		},
+		{
+			name:  "month-paging-uninitialized",
+			model: NewYear(2024),
+			msgs: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyPgDown},
+			},
+			wantYear:       2024,
+			wantActiveDate: time.Time{},
+			wantMsgs:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := tt.model
+			_ = tm.Init()
+
+			// Test
+			var gotMsgs []tea.Msg
+			for _, msg := range tt.msgs {
+				gotModel, gotCmd := tm.Update(msg)
+				tm = gotModel.(YearModel)
+				gotMsgs = append(gotMsgs, collectMsgs(gotCmd)...)
+			}
+
+			// Assertions
+			assert.Equal(t, tt.wantYear, tm.year)
+			assert.Equal(t, tt.wantActiveDate, tm.ActiveDate())
+			assert.Equal(t, tt.wantMsgs, gotMsgs)
+		})
+	}
+}
+
+func TestYearModel_View(t *testing.T) {
+	tests := []struct {
+		name        string
+		columns     int
+		startOfWeek time.Weekday
+		weekdays    Weekdays
+	}{
+		{
+			name:        "three-columns",
+			columns:     3,
+			startOfWeek: time.Sunday,
+			weekdays:    DefaultWeekdaysShort(),
+		},
+		{
+			name:        "four-columns-monday",
+			columns:     4,
+			startOfWeek: time.Monday,
+			weekdays:    DefaultWeekdaysShort(),
+		},
+		{
+			name:        "six-columns-five-day-week",
+			columns:     6,
+			startOfWeek: time.Sunday,
+			weekdays: Weekdays{
+				time.Monday:    "M",
+				time.Tuesday:   "T",
+				time.Wednesday: "W",
+				time.Thursday:  "R",
+				time.Friday:    "F",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tm := NewYear(2024).
+				Columns(tt.columns).
+				Weekdays(tt.weekdays).
+				StartOfWeek(tt.startOfWeek)
+			_ = tm.Init()
+			tm.activeDate = newDate(2024, time.March, 5)
+
+			// Test
+			got := ansi.Strip(tm.View())
+
+			// Assertions
+			golden.RequireEqual(t, []byte(got))
+		})
+	}
+}
+
+func TestYearModel_DateStyleFunc(t *testing.T) {
+	// Setup
+	var gotDates []time.Time
+	tm := NewYear(2024).DateStyleFunc(func(date time.Time, state DateState) gloss.Style {
+		gotDates = append(gotDates, date)
+		return gloss.NewStyle().Underline(true)
+	})
+
+	// Test
+	_ = tm.ViewMonth(time.February)
+
+	// Assertions
+	assert.Len(t, gotDates, 29)
+	assert.Equal(t, newDate(2024, time.February, 1), gotDates[0])
+	assert.Equal(t, newDate(2024, time.February, 29), gotDates[28])
+}
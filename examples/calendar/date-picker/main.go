@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/shawalli/bubbles/calendar"
+)
+
+type Model struct {
+	picker  calendar.DatePickerModel
+	picking bool
+
+	dueDate time.Time
+}
+
+func (m Model) Init() tea.Cmd { return nil }
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc", "q":
+			if !m.picking {
+				return m, tea.Quit
+			}
+		case "enter":
+			if !m.picking {
+				m.picking = true
+				m.picker = calendar.NewDatePicker(m.dueDate).
+					ShowInput(true).
+					InputLayout("01/02/2006")
+				return m, nil
+			}
+		}
+	case calendar.DatePickedMsg:
+		m.dueDate = msg.Date
+		m.picking = false
+		return m, nil
+	case calendar.DatePickCanceledMsg:
+		m.picking = false
+		return m, nil
+	}
+
+	if !m.picking {
+		return m, nil
+	}
+
+	n, cmd := m.picker.Update(msg)
+	m.picker = n.(calendar.DatePickerModel)
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	labelStyle := gloss.NewStyle().
+		Bold(true).
+		Width(12)
+	fieldStyle := gloss.NewStyle().
+		Underline(true)
+
+	form := gloss.JoinVertical(
+		gloss.Left,
+		labelStyle.Render("Task")+fieldStyle.Render("Renew certificates"),
+		labelStyle.Render("Owner")+fieldStyle.Render("Platform team"),
+		labelStyle.Render("Due date")+fieldStyle.Render(m.dueDate.Format("01/02/2006")),
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"enter: pick due date • tab: type date • q: quit",
+	)
+
+	if m.picking {
+		form = m.picker.ViewOverlay(form, 12, 3)
+	}
+
+	return gloss.NewStyle().
+		Border(gloss.RoundedBorder(), true).
+		Padding(0, 1).
+		Render(form)
+}
+
+func main() {
+	m := Model{
+		dueDate: time.Now(),
+	}
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Printf("could not run program: %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/shawalli/bubbles/calendar"
+)
+
+type Model struct {
+	schedule tea.Model
+
+	selected string
+}
+
+func (m Model) Init() tea.Cmd { return nil }
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, tea.Quit
+		}
+	case calendar.SlotSelectedMsg:
+		m.selected = fmt.Sprintf("Booked %s - %s", msg.Start.Format("3:04PM"), msg.End.Format("3:04PM"))
+	}
+
+	n, cmd := m.schedule.Update(msg)
+	m.schedule = n
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	window := gloss.JoinVertical(
+		gloss.Left,
+		m.schedule.View(),
+		"",
+		m.selected,
+	)
+	return gloss.NewStyle().
+		Border(gloss.RoundedBorder(), true).
+		Render(window)
+}
+
+func getDemoAppointments(date time.Time) []calendar.Appointment {
+	at := func(hour int, minute int) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, time.UTC)
+	}
+
+	return []calendar.Appointment{
+		{Title: "Core Hours", Start: at(9, 0), End: at(10, 30)},
+		{Title: "Refinement", Start: at(10, 30), End: at(11, 10)},
+		{Title: "Standup", Start: at(11, 15), End: at(11, 45)},
+		{Title: "Lunch", Start: at(12, 0), End: at(13, 0)},
+		{Title: "Core Hours", Start: at(14, 0), End: at(15, 30)},
+		{Title: "Metrics", Start: at(15, 30), End: at(16, 0)},
+	}
+}
+
+func main() {
+	date := time.Date(2024, time.October, 2, 0, 0, 0, 0, time.UTC)
+
+	m := Model{
+		schedule: calendar.NewDay(date).
+			TimeRange(8*time.Hour, 18*time.Hour).
+			SlotDuration(15 * time.Minute).
+			Height(16).
+			Appointments(getDemoAppointments(date)...),
+	}
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Printf("could not run program: %v", err)
+		os.Exit(1)
+	}
+}
@@ -21,6 +21,10 @@ type Log struct {
 type Model struct {
 	calendar tea.Model
 
+	// heatmap is an overview of the exercise counts, shown instead of the calendar while overview is set
+	heatmap  tea.Model
+	overview bool
+
 	activeDate time.Time
 
 	log map[string][]Log
@@ -35,6 +39,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.String() {
 		case "esc", "q", "ctrl+c":
 			return m, tea.Quit
+		case "tab":
+			m.overview = !m.overview
+			return m, nil
+		}
+
+		if m.overview {
+			n, cmd := m.heatmap.Update(msg)
+			m.heatmap = n
+			return m, cmd
+		}
+	case tea.MouseMsg:
+		// The heatmap covers the calendar while shown
+		if m.overview {
+			return m, nil
 		}
 	case calendar.ActiveDateMsg:
 		m.activeDate = msg.Date
@@ -71,9 +89,14 @@ func (m Model) viewLog() string {
 }
 
 func (m Model) View() string {
+	view := m.calendar.View()
+	if m.overview {
+		view = m.heatmap.View()
+	}
+
 	window := gloss.JoinHorizontal(
 		gloss.Top,
-		m.calendar.View(),
+		view,
 		strings.Repeat(" ", 6),
 		m.viewLog(),
 	)
@@ -144,10 +167,24 @@ func getDemoLogs() map[string][]Log {
 	return logs
 }
 
+// countExercises counts the exercises logged on each date.
+func countExercises(logs map[string][]Log) map[time.Time]float64 {
+	counts := make(map[time.Time]float64)
+	for ts, l := range logs {
+		d, _ := time.Parse("2006-01-02", ts)
+		counts[d] = float64(len(l))
+	}
+	return counts
+}
+
 func main() {
+	logs := getDemoLogs()
 	m := Model{
-		calendar: calendar.NewMonth(2024, time.September),
-		log:      getDemoLogs(),
+		// The calendar is drawn inside the window border
+		calendar: calendar.NewMonth(2024, time.September).Offset(1, 1),
+		heatmap: calendar.NewHeatmapWeeks(time.Date(2024, time.September, 30, 0, 0, 0, 0, time.UTC), 20).
+			Values(countExercises(logs)),
+		log: logs,
 	}
 
 	for ts, l := range m.log {
@@ -159,7 +196,7 @@ func main() {
 		m = n.(Model)
 	}
 
-	if _, err := tea.NewProgram(m).Run(); err != nil {
+	if _, err := tea.NewProgram(m, tea.WithMouseCellMotion()).Run(); err != nil {
 		fmt.Printf("could not run program: %v", err)
 		os.Exit(1)
 	}
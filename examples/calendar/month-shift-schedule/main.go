@@ -1,8 +1,10 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -60,16 +62,6 @@ func (m Model) View() string {
 		Render(window)
 }
 
-type contentModel struct {
-	employee string
-}
-
-func (m contentModel) Init() tea.Cmd                           { return nil }
-func (m contentModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) { return m, nil }
-func (m contentModel) View() string {
-	return m.employee
-}
-
 func getDemoShifts() map[string]Shift {
 	data := map[string]string{
 		"2024-09-01": "Alice",
@@ -107,7 +99,23 @@ func getDemoShifts() map[string]Shift {
 	return logs
 }
 
+func getShiftEvents(log map[string]Shift) calendar.EventList {
+	var events calendar.EventList
+	for _, shift := range log {
+		events = append(events, calendar.Event{
+			Title:  shift.employee,
+			Start:  shift.timestamp,
+			AllDay: true,
+		})
+	}
+	slices.SortFunc(events, func(a, b calendar.Event) int { return a.Start.Compare(b.Start) })
+	return events
+}
+
 func main() {
+	export := flag.Bool("ics", false, "write the shifts to stdout as an iCalendar file and exit")
+	flag.Parse()
+
 	s := calendar.DefaultMonthStyles()
 	s.DateStyles.Width = 15
 	s.DateStyles.BodyStyle = s.DateStyles.BodyStyle.Width(15)
@@ -121,16 +129,20 @@ func main() {
 				time.Saturday: "Saturday",
 				time.Sunday:   "Sunday",
 			}).
+			Holidays(calendar.USFederalHolidays()).
+			HolidayLabels(true).
+			Overflow(calendar.OverflowMore).
 			Styles(s),
 		log: getDemoShifts(),
 	}
+	m.calendar = m.calendar.(calendar.MonthModel).Events(getShiftEvents(m.log))
 
-	for _, shift := range m.log {
-		n, _ := m.Update(calendar.DayContentMsg{
-			Date:    shift.timestamp,
-			Content: contentModel{employee: shift.employee},
-		})
-		m = n.(Model)
+	if *export {
+		if err := calendar.WriteICS(os.Stdout, m.calendar.(calendar.MonthModel).VisibleEvents(), time.Now()); err != nil {
+			fmt.Printf("could not export shifts: %v", err)
+			os.Exit(1)
+		}
+		return
 	}
 
 	if _, err := tea.NewProgram(m).Run(); err != nil {
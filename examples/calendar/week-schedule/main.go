@@ -4,8 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"slices"
-	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -13,52 +11,12 @@ import (
 	"github.com/shawalli/bubbles/calendar"
 )
 
-type Appointment struct {
-	StartTime time.Time
-	EndTime   time.Time
-
-	Title string
-
-	Completed bool
-}
-
-type dayModel struct {
-	Date time.Time
-
-	Appointments []Appointment
-}
-
-func (m dayModel) Init() tea.Cmd                           { return nil }
-func (m dayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) { return m, nil }
-func (m dayModel) View() string {
-	slices.SortStableFunc(m.Appointments, func(a, b Appointment) int { return a.StartTime.Compare(b.EndTime) })
-
-	var appts []string
-
-	for _, a := range m.Appointments {
-		style := gloss.NewStyle().Align(gloss.Left).Width(10)
-		if a.Completed {
-			style = style.
-				Italic(true).
-				Foreground(gloss.Color("#FFA200"))
-		}
-
-		appt := strings.Join([]string{
-			style.Render(fmt.Sprintf("%s -", a.StartTime.Format("3:05PM"))),
-			style.Render(fmt.Sprintf("  %s", a.EndTime.Format("3:05PM"))),
-			style.Render(a.Title),
-		}, "\n")
-
-		appts = append(appts, appt)
-	}
-
-	return gloss.JoinVertical(gloss.Top, strings.Join(appts, "\n\n"))
-}
-
 type Model struct {
 	schedule tea.Model
 
 	activeDate time.Time
+
+	timeGrid bool
 }
 
 func (m Model) Init() tea.Cmd { return nil }
@@ -70,6 +28,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.String() {
 		case "esc", "q", "ctrl+c":
 			return m, tea.Quit
+		case "g":
+			m.timeGrid = !m.timeGrid
+			m.schedule = m.schedule.(calendar.WeekModel).TimeGrid(m.timeGrid)
+			return m, nil
 		}
 	case calendar.ActiveDateMsg:
 		m.activeDate = msg.Date
@@ -85,7 +47,16 @@ func (m Model) View() string {
 	return m.schedule.View()
 }
 
-func getDemoAppointments() map[time.Time][]Appointment {
+type demoEvent struct {
+	StartTime time.Time
+	EndTime   time.Time
+
+	Title string
+
+	Completed bool
+}
+
+func getDemoEvents() calendar.EventList {
 	data := []map[string]interface{}{
 		{
 			"startTime": "2024-09-30T09:00:00Z",
@@ -191,28 +162,40 @@ func getDemoAppointments() map[time.Time][]Appointment {
 		},
 	}
 
-	days := make(map[time.Time][]Appointment)
+	var events calendar.EventList
 	for _, d := range data {
 		b, err := json.Marshal(&d)
 		if err != nil {
 			panic(fmt.Sprintf("unexpected error marshalling demo data: %v", err))
 		}
 
-		var appt Appointment
-		if err := json.Unmarshal(b, &appt); err != nil {
+		var e demoEvent
+		if err := json.Unmarshal(b, &e); err != nil {
 			panic(fmt.Sprintf("unexpected error unmarshalling demo data: %v", err))
 		}
 
-		key := time.Date(appt.StartTime.Year(), appt.StartTime.Month(), appt.StartTime.Day(), 0, 0, 0, 0, time.UTC)
-		day := days[key]
-		day = append(day, appt)
-		days[key] = day
+		event := calendar.Event{
+			Title: e.Title,
+			Start: e.StartTime,
+			End:   e.EndTime,
+		}
+		if e.Completed {
+			event.Category = "completed"
+		}
+		events = append(events, event)
 	}
 
-	return days
+	return events
 }
 
 func main() {
+	styles := calendar.DefaultWeekStyles()
+	styles.DateStyles.EventStyles.CategoryStyles = map[string]gloss.Style{
+		"completed": gloss.NewStyle().
+			Italic(true).
+			Foreground(gloss.Color("#FFA200")),
+	}
+
 	m := Model{
 		schedule: calendar.NewWeek(
 			time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC),
@@ -222,18 +205,11 @@ func main() {
 			time.Wednesday: "Wed",
 			time.Thursday:  "Thu",
 			time.Friday:    "Fri",
-		}),
-	}
-
-	for ts, d := range getDemoAppointments() {
-		n, _ := m.Update(calendar.DayContentMsg{
-			Date: ts,
-			Content: dayModel{
-				Date:         ts,
-				Appointments: d,
-			},
-		})
-		m = n.(Model)
+		}).TimeRange(8*time.Hour, 17*time.Hour).
+			Styles(styles).
+			Events(getDemoEvents()).
+			Overflow(calendar.OverflowScroll).
+			FitWindow(true),
 	}
 
 	if _, err := tea.NewProgram(m).Run(); err != nil {
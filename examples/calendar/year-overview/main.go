@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gloss "github.com/charmbracelet/lipgloss"
+	"github.com/shawalli/bubbles/calendar"
+)
+
+type Model struct {
+	calendar tea.Model
+
+	activeDate time.Time
+}
+
+func (m Model) Init() tea.Cmd { return nil }
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, tea.Quit
+		}
+	case calendar.ActiveDateMsg:
+		m.activeDate = msg.Date
+	}
+
+	n, cmd := m.calendar.Update(msg)
+	m.calendar = n
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	titleStyle := gloss.NewStyle().
+		Bold(true).
+		Underline(true).
+		Foreground(gloss.Color("#22C11D"))
+
+	status := ""
+	if day, ok := getDemoSchedule()[m.activeDate.Format("2006-01-02")]; ok {
+		status = day
+	}
+
+	window := gloss.JoinVertical(
+		gloss.Center,
+		titleStyle.Render(m.calendar.(calendar.YearModel).Title()),
+		"",
+		m.calendar.View(),
+		status,
+	)
+	return gloss.NewStyle().
+		Border(gloss.RoundedBorder(), true).
+		Render(window)
+}
+
+func getDemoSchedule() map[string]string {
+	schedule := map[string]string{
+		"2024-12-24": "PTO",
+		"2024-12-25": "PTO",
+		"2024-12-26": "PTO",
+	}
+
+	for _, start := range []string{"2024-03-04", "2024-06-10", "2024-09-16", "2024-11-25"} {
+		d, _ := time.Parse("2006-01-02", start)
+		for i := 0; i < 7; i++ {
+			schedule[d.AddDate(0, 0, i).Format("2006-01-02")] = "On-Call"
+		}
+	}
+	for _, start := range []string{"2024-07-01"} {
+		d, _ := time.Parse("2006-01-02", start)
+		for i := 0; i < 5; i++ {
+			schedule[d.AddDate(0, 0, i).Format("2006-01-02")] = "PTO"
+		}
+	}
+
+	return schedule
+}
+
+func main() {
+	schedule := getDemoSchedule()
+
+	m := Model{
+		calendar: calendar.NewYear(2024).
+			Columns(4).
+			DateStyleFunc(func(date time.Time, _ calendar.DateState) gloss.Style {
+				switch schedule[date.Format("2006-01-02")] {
+				case "PTO":
+					return gloss.NewStyle().Foreground(gloss.Color("#22C11D"))
+				case "On-Call":
+					return gloss.NewStyle().Foreground(gloss.Color("#FFA200"))
+				}
+				return gloss.NewStyle()
+			}),
+	}
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Printf("could not run program: %v", err)
+		os.Exit(1)
+	}
+}